@@ -5,6 +5,7 @@ go 1.25.0
 require (
 	github.com/go-resty/resty/v2 v2.16.5
 	github.com/stretchr/testify v1.8.4
+	golang.org/x/text v0.21.0
 )
 
 require (
@@ -0,0 +1,384 @@
+// Package calendar 提供沪深交易所交易日历及交易日推算
+package calendar
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/common/client"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// holidaysData 内置的历史休市日快照，离线可用
+//
+//go:embed holidays.txt
+var holidaysData string
+
+const dateLayout = "2006-01-02"
+
+// Calendar 交易日历，默认加载内置快照，可通过 Refresh 从交易所更新指定年份
+// 快照只覆盖到发布时已公布休市安排的年份，之后的年份需通过 Refresh 或 RefreshUpcoming 获取
+type Calendar struct {
+	client *client.Client
+
+	mu       sync.RWMutex
+	years    map[int]bool    // 已覆盖的年份
+	holidays map[string]bool // 周一至周五的休市日
+}
+
+// New 创建交易日历实例并加载内置快照，快照解析失败时返回错误
+func New() (*Calendar, error) {
+	c := &Calendar{
+		client:   client.NewClient(),
+		years:    make(map[int]bool),
+		holidays: make(map[string]bool),
+	}
+
+	if err := c.loadSnapshot(holidaysData); err != nil {
+		return nil, errors.WrapError(err, "内置交易日历解析失败")
+	}
+
+	return c, nil
+}
+
+// SetProxy 设置代理
+func (c *Calendar) SetProxy(enabled bool, proxyURL string) {
+	c.client.SetProxy(enabled, proxyURL)
+}
+
+// Years 返回已覆盖的年份，升序排列
+func (c *Calendar) Years() []int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	years := make([]int, 0, len(c.years))
+	for year := range c.years {
+		years = append(years, year)
+	}
+	sort.Ints(years)
+	return years
+}
+
+// Refresh 获取指定年份的交易日历并覆盖本地数据，获取失败或不完整时返回错误且不修改本地数据
+// 优先使用深交所月度日历（含未来月份），失败时改用上交所上证指数日K线（仅限已结束的年份）；
+// 沪深两市休市安排一致，任一交易所数据均适用于两市
+func (c *Calendar) Refresh(year int) error {
+	days, szseErr := FetchSZSE(c.client, year)
+	if szseErr != nil {
+		var sseErr error
+		days, sseErr = FetchSSE(c.client, year)
+		if sseErr != nil {
+			return errors.NewADataError(errors.ErrDataSourceUnavailable.Code, "交易日历数据源均不可用",
+				fmt.Sprintf("深交所: %v; 上交所: %v", szseErr, sseErr))
+		}
+	}
+	return c.Load(days)
+}
+
+// RefreshUpcoming 从深交所获取当年和次年的交易日历，使内置快照结束后仍可使用
+// 当年获取失败时返回错误；次年休市安排通常于当年12月公布，尚未公布时跳过次年且不返回错误，
+// 此时次年日期仍超出覆盖范围，需在公布后再次调用
+func (c *Calendar) RefreshUpcoming() error {
+	year := time.Now().In(Shanghai).Year()
+
+	if err := c.Refresh(year); err != nil {
+		return err
+	}
+
+	days, err := FetchSZSE(c.client, year+1)
+	if err != nil {
+		if errors.IsNoDataFound(err) {
+			return nil
+		}
+		return err
+	}
+	return c.Load(days)
+}
+
+// Load 使用完整年度的交易日历覆盖对应年份，数据须包含该年每一个自然日
+func (c *Calendar) Load(days []types.TradeCalendar) error {
+	if len(days) == 0 {
+		return errors.NewADataError(errors.ErrNoDataFound.Code, "交易日历为空", "")
+	}
+
+	byYear := make(map[int]map[string]bool)
+	for _, day := range days {
+		t, err := time.Parse(dateLayout, day.TradeDate)
+		if err != nil {
+			return errors.NewADataError(errors.ErrInvalidDateFormat.Code, "交易日历日期格式错误", day.TradeDate)
+		}
+		if byYear[t.Year()] == nil {
+			byYear[t.Year()] = make(map[string]bool)
+		}
+		byYear[t.Year()][day.TradeDate] = day.TradeStatus == 1
+	}
+
+	for year, status := range byYear {
+		if len(status) != daysInYear(year) {
+			return errors.NewADataError(errors.ErrNoDataFound.Code, "交易日历不完整",
+				fmt.Sprintf("%d 年仅包含 %d 天", year, len(status)))
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for year, status := range byYear {
+		prefix := strconv.Itoa(year) + "-"
+		for date := range c.holidays {
+			if strings.HasPrefix(date, prefix) {
+				delete(c.holidays, date)
+			}
+		}
+		for date, open := range status {
+			if !open && !isWeekend(mustParse(date)) {
+				c.holidays[date] = true
+			}
+		}
+		c.years[year] = true
+	}
+
+	return nil
+}
+
+// Days 返回指定年份每一个自然日的交易状态
+func (c *Calendar) Days(year int) ([]types.TradeCalendar, error) {
+	start := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if !c.years[year] {
+		return nil, outOfRange(start)
+	}
+
+	days := make([]types.TradeCalendar, 0, daysInYear(year))
+	for t := start; t.Year() == year; t = t.AddDate(0, 0, 1) {
+		day := types.TradeCalendar{
+			TradeDate: t.Format(dateLayout),
+			DayWeek:   int(t.Weekday()) + 1,
+		}
+		if c.isOpen(t) {
+			day.TradeStatus = 1
+		}
+		days = append(days, day)
+	}
+
+	return days, nil
+}
+
+// IsTradingDay 判断指定日期是否为交易日
+func (c *Calendar) IsTradingDay(date string) (bool, error) {
+	t, err := parseDate(date)
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if !c.years[t.Year()] {
+		return false, outOfRange(t)
+	}
+	return c.isOpen(t), nil
+}
+
+// NextTradingDay 返回指定日期之后的第一个交易日
+func (c *Calendar) NextTradingDay(date string) (string, error) {
+	return c.NthTradingDay(date, 1)
+}
+
+// PrevTradingDay 返回指定日期之前的最后一个交易日
+func (c *Calendar) PrevTradingDay(date string) (string, error) {
+	return c.NthTradingDay(date, -1)
+}
+
+// NthTradingDay 返回指定日期之后第 n 个交易日，n 为负数时向前推算
+// n 为 0 时，指定日期为交易日则返回其本身，否则返回之后的第一个交易日
+func (c *Calendar) NthTradingDay(date string, n int) (string, error) {
+	t, err := parseDate(date)
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if n == 0 {
+		if !c.years[t.Year()] {
+			return "", outOfRange(t)
+		}
+		if c.isOpen(t) {
+			return t.Format(dateLayout), nil
+		}
+		n = 1
+	}
+
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	for n > 0 {
+		t = t.AddDate(0, 0, step)
+		if !c.years[t.Year()] {
+			return "", outOfRange(t)
+		}
+		if c.isOpen(t) {
+			n--
+		}
+	}
+
+	return t.Format(dateLayout), nil
+}
+
+// TradingDaysBetween 返回区间内的全部交易日（含首尾），按日期升序排列
+func (c *Calendar) TradingDaysBetween(startDate, endDate string) ([]string, error) {
+	start, err := parseDate(startDate)
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseDate(endDate)
+	if err != nil {
+		return nil, err
+	}
+	if start.After(end) {
+		return nil, errors.NewADataError(errors.ErrInvalidDateFormat.Code, "开始日期不能晚于结束日期",
+			fmt.Sprintf("%s > %s", start.Format(dateLayout), end.Format(dateLayout)))
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var days []string
+	for t := start; !t.After(end); t = t.AddDate(0, 0, 1) {
+		if !c.years[t.Year()] {
+			return nil, outOfRange(t)
+		}
+		if c.isOpen(t) {
+			days = append(days, t.Format(dateLayout))
+		}
+	}
+
+	return days, nil
+}
+
+// LastTradingDayOfMonth 返回指定月份的最后一个交易日
+func (c *Calendar) LastTradingDayOfMonth(year int, month time.Month) (string, error) {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1)
+	return c.lastTradingDayIn(first, last)
+}
+
+// LastTradingDayOfWeek 返回指定日期所在自然周（周一至周日）的最后一个交易日，整周休市时返回错误
+func (c *Calendar) LastTradingDayOfWeek(date string) (string, error) {
+	t, err := parseDate(date)
+	if err != nil {
+		return "", err
+	}
+
+	// 以周一为一周的开始
+	offset := (int(t.Weekday()) + 6) % 7
+	monday := t.AddDate(0, 0, -offset)
+	return c.lastTradingDayIn(monday, monday.AddDate(0, 0, 6))
+}
+
+// lastTradingDayIn 返回区间内的最后一个交易日
+func (c *Calendar) lastTradingDayIn(first, last time.Time) (string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for t := last; !t.Before(first); t = t.AddDate(0, 0, -1) {
+		if !c.years[t.Year()] {
+			return "", outOfRange(t)
+		}
+		if c.isOpen(t) {
+			return t.Format(dateLayout), nil
+		}
+	}
+
+	return "", errors.NewADataError(errors.ErrNoDataFound.Code, "区间内没有交易日",
+		fmt.Sprintf("%s ~ %s", first.Format(dateLayout), last.Format(dateLayout)))
+}
+
+// isOpen 判断是否开市，调用方需持有读锁并确认年份已覆盖
+func (c *Calendar) isOpen(t time.Time) bool {
+	return !isWeekend(t) && !c.holidays[t.Format(dateLayout)]
+}
+
+// loadSnapshot 解析内置快照，格式为 range 起始年 结束年 及每行一个休市日期
+func (c *Calendar) loadSnapshot(data string) error {
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if fields[0] == "range" {
+			if len(fields) != 3 {
+				return fmt.Errorf("invalid range line: %s", line)
+			}
+			from, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return err
+			}
+			to, err := strconv.Atoi(fields[2])
+			if err != nil {
+				return err
+			}
+			for year := from; year <= to; year++ {
+				c.years[year] = true
+			}
+			continue
+		}
+
+		t, err := time.Parse(dateLayout, fields[0])
+		if err != nil {
+			return err
+		}
+		c.holidays[t.Format(dateLayout)] = true
+	}
+
+	return scanner.Err()
+}
+
+// parseDate 解析日期，支持 utils.FormatDate 的全部格式
+func parseDate(date string) (time.Time, error) {
+	formatted, err := utils.FormatDate(date)
+	if err != nil || formatted == "" {
+		return time.Time{}, errors.ErrInvalidDateFormat
+	}
+	return mustParse(formatted), nil
+}
+
+// mustParse 解析已校验的 YYYY-MM-DD 日期
+func mustParse(date string) time.Time {
+	t, _ := time.Parse(dateLayout, date)
+	return t
+}
+
+// isWeekend 判断是否为周六或周日
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
+
+// daysInYear 返回指定年份的自然日天数
+func daysInYear(year int) int {
+	return time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+// outOfRange 构造日期超出日历覆盖范围的错误
+func outOfRange(t time.Time) error {
+	return errors.NewADataError(errors.ErrNoDataFound.Code, "日期超出交易日历覆盖范围",
+		fmt.Sprintf("%s，请先调用 Refresh(%d) 或 RefreshUpcoming 获取该年份日历", t.Format(dateLayout), t.Year()))
+}
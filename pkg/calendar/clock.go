@@ -0,0 +1,190 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/symbol"
+)
+
+// Shanghai 沪深市场所在时区，自 1991 年起无夏令时，使用固定偏移避免依赖系统时区数据
+var Shanghai = time.FixedZone("Asia/Shanghai", 8*60*60)
+
+// Phase 交易阶段
+type Phase string
+
+// 交易阶段
+const (
+	PhaseClosed         Phase = "闭市"       // 非交易日、开盘前、收盘后
+	PhasePreOpenAuction Phase = "开盘集合竞价"   // 9:15-9:25
+	PhasePreOpenBreak   Phase = "开盘前申报"    // 9:25-9:30，集合竞价撮合完成后只接受申报，不撮合成交
+	PhaseContinuous     Phase = "连续竞价"     // 9:30-11:30、13:00-14:57
+	PhaseLunchBreak     Phase = "午间休市"     // 11:30-13:00
+	PhaseClosingAuction Phase = "收盘集合竞价"   // 14:57-15:00
+	PhaseAfterHours     Phase = "盘后固定价格交易" // 15:05-15:30，仅科创板和创业板
+)
+
+// session 交易时段，以当日分钟数表示，左闭右开
+type session struct {
+	start, end int
+	phase      Phase
+}
+
+// 交易日内各时段
+var sessions = []session{
+	{9*60 + 15, 9*60 + 25, PhasePreOpenAuction},
+	{9*60 + 25, 9*60 + 30, PhasePreOpenBreak},
+	{9*60 + 30, 11*60 + 30, PhaseContinuous},
+	{11*60 + 30, 13 * 60, PhaseLunchBreak},
+	{13 * 60, 14*60 + 57, PhaseContinuous},
+	{14*60 + 57, 15 * 60, PhaseClosingAuction},
+}
+
+// afterHours 盘后固定价格交易时段
+var afterHours = session{15*60 + 5, 15*60 + 30, PhaseAfterHours}
+
+// 连续竞价开始和结束时刻（分钟），用于推算下一次开盘和收盘
+var (
+	openMinutes  = []int{9*60 + 30, 13 * 60}
+	closeMinutes = []int{11*60 + 30, 15 * 60}
+)
+
+// maxSearchDays 推算下一次开盘或收盘时最多向后查找的自然日数
+const maxSearchDays = 366
+
+// Clock 按上海时间和交易日历判断交易阶段的市场时钟
+type Clock struct {
+	calendar *Calendar
+	now      func() time.Time
+}
+
+// NewClock 创建市场时钟，cal 为空时使用内置交易日历；快照覆盖范围之后的日期需传入已调用 RefreshUpcoming 的日历
+func NewClock(cal *Calendar) (*Clock, error) {
+	if cal == nil {
+		var err error
+		if cal, err = New(); err != nil {
+			return nil, err
+		}
+	}
+	return &Clock{
+		calendar: cal,
+		now:      time.Now,
+	}, nil
+}
+
+// SetNow 替换当前时间来源，用于测试或回放
+func (c *Clock) SetNow(now func() time.Time) {
+	c.now = now
+}
+
+// Now 返回上海时间的当前时刻
+func (c *Clock) Now() time.Time {
+	return c.now().In(Shanghai)
+}
+
+// Phase 返回当前交易阶段，stockCode 用于区分科创板和创业板的盘后固定价格交易，可为空
+func (c *Clock) Phase(stockCode string) (Phase, error) {
+	return c.PhaseAt(c.Now(), stockCode)
+}
+
+// PhaseAt 返回指定时刻的交易阶段
+func (c *Clock) PhaseAt(t time.Time, stockCode string) (Phase, error) {
+	t = t.In(Shanghai)
+
+	open, err := c.calendar.IsTradingDay(t.Format(dateLayout))
+	if err != nil {
+		return PhaseClosed, err
+	}
+	if !open {
+		return PhaseClosed, nil
+	}
+
+	minute := t.Hour()*60 + t.Minute()
+	for _, s := range sessions {
+		if minute >= s.start && minute < s.end {
+			return s.phase, nil
+		}
+	}
+
+	if minute >= afterHours.start && minute < afterHours.end && hasAfterHours(stockCode) {
+		return PhaseAfterHours, nil
+	}
+
+	return PhaseClosed, nil
+}
+
+// IsTrading 判断当前是否处于集合竞价或连续竞价阶段，9:25-9:30 只接受申报不撮合，不视为交易中
+func (c *Clock) IsTrading() (bool, error) {
+	phase, err := c.Phase("")
+	if err != nil {
+		return false, err
+	}
+	return phase == PhasePreOpenAuction || phase == PhaseContinuous || phase == PhaseClosingAuction, nil
+}
+
+// NextOpen 返回当前时刻之后下一次连续竞价开始的时刻（上午 9:30 或下午 13:00）
+func (c *Clock) NextOpen() (time.Time, error) {
+	return c.next(c.Now(), openMinutes)
+}
+
+// NextClose 返回当前时刻之后下一次连续竞价结束的时刻（上午 11:30 或收盘 15:00）
+func (c *Clock) NextClose() (time.Time, error) {
+	return c.next(c.Now(), closeMinutes)
+}
+
+// UntilOpen 返回距离下一次开盘的时长
+func (c *Clock) UntilOpen() (time.Duration, error) {
+	now := c.Now()
+	next, err := c.next(now, openMinutes)
+	if err != nil {
+		return 0, err
+	}
+	return next.Sub(now), nil
+}
+
+// UntilClose 返回距离下一次收盘的时长
+func (c *Clock) UntilClose() (time.Duration, error) {
+	now := c.Now()
+	next, err := c.next(now, closeMinutes)
+	if err != nil {
+		return 0, err
+	}
+	return next.Sub(now), nil
+}
+
+// next 查找 from 之后第一个位于交易日的指定时刻
+func (c *Clock) next(from time.Time, minutes []int) (time.Time, error) {
+	from = from.In(Shanghai)
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, Shanghai)
+
+	for i := 0; i < maxSearchDays; i++ {
+		open, err := c.calendar.IsTradingDay(day.Format(dateLayout))
+		if err != nil {
+			return time.Time{}, err
+		}
+		if open {
+			for _, m := range minutes {
+				t := day.Add(time.Duration(m) * time.Minute)
+				if t.After(from) {
+					return t, nil
+				}
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return time.Time{}, errors.NewADataError(errors.ErrNoDataFound.Code, "未找到下一个交易时段", from.Format(time.RFC3339))
+}
+
+// hasAfterHours 判断股票是否有盘后固定价格交易
+func hasAfterHours(stockCode string) bool {
+	if stockCode == "" {
+		return false
+	}
+	sym, err := symbol.Parse(stockCode)
+	if err != nil {
+		return false
+	}
+	board := sym.Board()
+	return board == "科创板" || board == "创业板"
+}
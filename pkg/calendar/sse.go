@@ -0,0 +1,75 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/common/client"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/headers"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// sseIndexDayKURL 上交所上证指数日K线，用于推出上交所历史交易日
+const sseIndexDayKURL = "https://yunhq.sse.com.cn:32042/v1/sh1/dayk/000001"
+
+// FetchSSE 从上交所上证指数日K线推出指定年份每一个自然日的交易状态
+// 日K线只包含已发生的交易日，因此仅支持已结束的年份，当年及以后的年份返回错误
+func FetchSSE(c *client.Client, year int) ([]types.TradeCalendar, error) {
+	params := map[string]string{
+		"select": "date",
+		"begin":  "0",
+		"end":    "-1",
+	}
+
+	// kline 每项为只含日期的数组，如 [20240102]
+	var result struct {
+		Kline [][]int `json:"kline"`
+	}
+
+	err := c.GetJSON(sseIndexDayKURL, params, headers.GetSSEHeaders(), &result)
+	if err != nil {
+		return nil, errors.WrapError(err, "获取上交所交易日历失败: "+strconv.Itoa(year))
+	}
+
+	open := make(map[string]bool)
+	latest := ""
+	for _, item := range result.Kline {
+		if len(item) == 0 {
+			continue
+		}
+		t, err := time.Parse("20060102", strconv.Itoa(item[0]))
+		if err != nil {
+			return nil, errors.NewADataError(errors.ErrParseResponseFailed.Code, "上交所交易日历日期异常", strconv.Itoa(item[0]))
+		}
+		date := t.Format(dateLayout)
+		if t.Year() == year {
+			open[date] = true
+		}
+		if date > latest {
+			latest = date
+		}
+	}
+
+	// 最新交易日晚于该年年末才能确认全年交易日均已出现
+	if latest <= fmt.Sprintf("%d-12-31", year) || len(open) == 0 {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "上交所交易日历不完整",
+			fmt.Sprintf("%d 年尚未结束或无数据，最新交易日 %s", year, latest))
+	}
+
+	days := make([]types.TradeCalendar, 0, daysInYear(year))
+	for t := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC); t.Year() == year; t = t.AddDate(0, 0, 1) {
+		date := t.Format(dateLayout)
+		day := types.TradeCalendar{
+			TradeDate: date,
+			DayWeek:   int(t.Weekday()) + 1,
+		}
+		if open[date] {
+			day.TradeStatus = 1
+		}
+		days = append(days, day)
+	}
+
+	return days, nil
+}
@@ -0,0 +1,87 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/common/client"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// szseMonthListURL 深交所月度交易日历
+const szseMonthListURL = "http://www.szse.cn/api/report/exchange/onepersistenthour/monthList"
+
+// FetchSZSE 从深交所获取指定年份每一个自然日的交易状态，任一月份请求失败或天数不完整时返回错误
+// 深交所在公布休市安排前对未来月份按工作日返回交易日，全年工作日均为交易日时视为尚未公布并返回错误
+func FetchSZSE(c *client.Client, year int) ([]types.TradeCalendar, error) {
+	var days []types.TradeCalendar
+	weekdayHolidays := 0
+
+	for month := time.January; month <= time.December; month++ {
+		monthDays, err := fetchSZSEMonth(c, year, month)
+		if err != nil {
+			return nil, err
+		}
+		for _, day := range monthDays {
+			if day.TradeStatus == 0 && !isWeekend(mustParse(day.TradeDate)) {
+				weekdayHolidays++
+			}
+		}
+		days = append(days, monthDays...)
+	}
+
+	// 春节每年都有工作日休市
+	if weekdayHolidays == 0 {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "深交所尚未公布该年休市安排", strconv.Itoa(year))
+	}
+
+	return days, nil
+}
+
+// fetchSZSEMonth 获取单月交易日历并校验完整性
+func fetchSZSEMonth(c *client.Client, year int, month time.Month) ([]types.TradeCalendar, error) {
+	monthStr := fmt.Sprintf("%d-%02d", year, int(month))
+	params := map[string]string{
+		"month": monthStr,
+	}
+
+	var result struct {
+		Data []struct {
+			Jyrq string `json:"jyrq"` // 日期
+			Jybz string `json:"jybz"` // 交易标志：1 交易日，0 休市
+			Zrxh int    `json:"zrxh"` // 自然序号，周日为 1
+		} `json:"data"`
+	}
+
+	err := c.GetJSON(szseMonthListURL, params, nil, &result)
+	if err != nil {
+		return nil, errors.WrapError(err, "获取深交所交易日历失败: "+monthStr)
+	}
+
+	expected := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if len(result.Data) != expected {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "深交所交易日历不完整",
+			fmt.Sprintf("%s 返回 %d 天，应为 %d 天", monthStr, len(result.Data), expected))
+	}
+
+	days := make([]types.TradeCalendar, 0, expected)
+	for _, item := range result.Data {
+		t, err := time.Parse(dateLayout, item.Jyrq)
+		if err != nil || t.Year() != year || t.Month() != month {
+			return nil, errors.NewADataError(errors.ErrParseResponseFailed.Code, "深交所交易日历日期异常", item.Jyrq)
+		}
+
+		day := types.TradeCalendar{
+			TradeDate: item.Jyrq,
+			DayWeek:   int(t.Weekday()) + 1,
+		}
+		if item.Jybz == "1" {
+			day.TradeStatus = 1
+		}
+		days = append(days, day)
+	}
+
+	return days, nil
+}
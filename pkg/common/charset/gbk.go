@@ -0,0 +1,32 @@
+// Package charset 提供数据源响应的字符集转换
+package charset
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+// DecodeGBK 将 GBK 编码的字节解码为 UTF-8 字符串，无效编码替换为 U+FFFD
+func DecodeGBK(b []byte) string {
+	decoded, err := simplifiedchinese.GBK.NewDecoder().Bytes(b)
+	if err != nil {
+		return strings.ToValidUTF8(string(b), string(utf8.RuneError))
+	}
+	return string(decoded)
+}
+
+// DecodeAuto 根据内容类型或字节内容自动选择解码方式：已是合法 UTF-8 时原样返回，否则按 GBK 解码
+func DecodeAuto(b []byte, contentType string) string {
+	ct := strings.ToLower(contentType)
+	if strings.Contains(ct, "gbk") || strings.Contains(ct, "gb2312") || strings.Contains(ct, "gb18030") {
+		return DecodeGBK(b)
+	}
+
+	if utf8.Valid(b) {
+		return string(b)
+	}
+
+	return DecodeGBK(b)
+}
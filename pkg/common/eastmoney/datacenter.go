@@ -0,0 +1,159 @@
+// Package eastmoney 封装东方财富数据中心的通用分页查询
+package eastmoney
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/common/client"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/headers"
+)
+
+// 东方财富数据中心接口地址
+const (
+	DatacenterURL = "https://datacenter-web.eastmoney.com/api/data/v1/get"        // 数据中心
+	SecuritiesURL = "https://datacenter.eastmoney.com/securities/api/data/v1/get" // 个股 F10，需配合 Source "HSF10"、Client "PC"
+)
+
+// emptyResultCode 数据中心查询结果为空时返回的错误码，对应消息"返回数据为空"
+const emptyResultCode = 9201
+
+// Query 数据中心查询参数
+type Query struct {
+	URL         string // 接口地址，为空时使用 DatacenterURL
+	Source      string // 来源标识，为空时默认 WEB
+	Client      string // 客户端标识，为空时默认 WEB
+	ReportName  string // 报表名称，如 RPT_DAILYBILLBOARD_DETAILSNEW
+	Columns     string // 返回列，为空时返回全部列
+	Filter      string // 过滤条件，如 (TRADE_DATE>='2024-01-01')
+	SortColumns string // 排序列
+	SortTypes   string // 排序方式：1-升序，-1-降序
+	PageSize    int    // 每页条数，为空时默认500
+	MaxPages    int    // 最大页数，为空时默认100，总页数超过时 FetchAll 返回错误
+}
+
+// Page 数据中心单页结果
+type Page struct {
+	Pages int             // 总页数
+	Count int             // 总条数
+	Data  json.RawMessage // 当前页数据
+}
+
+// FetchPage 获取指定页的数据
+// 查询结果为空时返回 ErrNoDataFound 错误码，接口返回其他失败时返回 ErrRequestFailed 错误码
+func FetchPage(c *client.Client, q Query, pageNumber int) (*Page, error) {
+	columns := q.Columns
+	if columns == "" {
+		columns = "ALL"
+	}
+
+	pageSize := q.PageSize
+	if pageSize <= 0 {
+		pageSize = 500
+	}
+
+	source := q.Source
+	if source == "" {
+		source = "WEB"
+	}
+
+	clientName := q.Client
+	if clientName == "" {
+		clientName = "WEB"
+	}
+
+	params := map[string]string{
+		"reportName": q.ReportName,
+		"columns":    columns,
+		"filter":     q.Filter,
+		"pageNumber": strconv.Itoa(pageNumber),
+		"pageSize":   strconv.Itoa(pageSize),
+		"source":     source,
+		"client":     clientName,
+		"_":          strconv.FormatInt(time.Now().UnixMilli(), 10),
+	}
+	if q.SortColumns != "" {
+		params["sortColumns"] = q.SortColumns
+		params["sortTypes"] = q.SortTypes
+	}
+
+	var result struct {
+		Success bool   `json:"success"`
+		Message string `json:"message"`
+		Code    int    `json:"code"`
+		Result  *struct {
+			Pages int             `json:"pages"`
+			Count int             `json:"count"`
+			Data  json.RawMessage `json:"data"`
+		} `json:"result"`
+	}
+
+	url := q.URL
+	if url == "" {
+		url = DatacenterURL
+	}
+
+	err := c.GetJSON(url, params, headers.EastMoneyHeaders, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Success && result.Code != emptyResultCode {
+		return nil, errors.NewADataError(errors.ErrRequestFailed.Code, "数据中心查询失败",
+			fmt.Sprintf("%s: code=%d, message=%s", q.ReportName, result.Code, result.Message))
+	}
+	if result.Result == nil {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "未找到数据", result.Message)
+	}
+
+	return &Page{
+		Pages: result.Result.Pages,
+		Count: result.Result.Count,
+		Data:  result.Result.Data,
+	}, nil
+}
+
+// FetchAll 按页获取全部数据并解析为指定类型，总页数超过 MaxPages 时返回错误而不是截断结果
+func FetchAll[T any](c *client.Client, q Query) ([]T, error) {
+	maxPages := q.MaxPages
+	if maxPages <= 0 {
+		maxPages = 100
+	}
+
+	var all []T
+	for pageNumber := 1; pageNumber <= maxPages; pageNumber++ {
+		page, err := FetchPage(c, q, pageNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		var items []T
+		if err := json.Unmarshal(page.Data, &items); err != nil {
+			return nil, errors.NewADataError(errors.ErrParseResponseFailed.Code, "JSON解析失败", err.Error())
+		}
+		if page.Pages > maxPages {
+			return nil, errors.NewADataError(errors.ErrRequestFailed.Code, "数据页数超过上限",
+				fmt.Sprintf("%s: 共%d页，上限%d页，请缩小查询范围或调大 MaxPages", q.ReportName, page.Pages, maxPages))
+		}
+		all = append(all, items...)
+
+		if pageNumber >= page.Pages || len(items) == 0 {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return all, nil
+}
+
+// FormatDate 截取数据中心日期字段的日期部分，如 "2024-01-10 00:00:00" -> "2024-01-10"
+func FormatDate(s string) string {
+	if len(s) >= 10 {
+		return s[:10]
+	}
+	return s
+}
@@ -31,6 +31,12 @@ var (
 		Message: "无效的日期格式",
 	}
 
+	// ErrInvalidParameter 无效参数
+	ErrInvalidParameter = &ADataError{
+		Code:    10003,
+		Message: "无效的参数",
+	}
+
 	// ErrRequestFailed 请求失败
 	ErrRequestFailed = &ADataError{
 		Code:    20001,
@@ -73,3 +79,9 @@ func WrapError(err error, message string) *ADataError {
 		Detail:  err.Error(),
 	}
 }
+
+// IsNoDataFound 判断是否为数据源未返回数据的错误
+func IsNoDataFound(err error) bool {
+	adataErr, ok := err.(*ADataError)
+	return ok && adataErr.Code == ErrNoDataFound.Code
+}
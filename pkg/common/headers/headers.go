@@ -78,6 +78,18 @@ func GetTHSHeaders() map[string]string {
 	}
 }
 
+// GetTHSAjaxHeaders 同花顺异步接口请求头，hexin-v 令牌同时放入请求头和 Cookie
+func GetTHSAjaxHeaders(hexinV string) map[string]string {
+	h := GetTHSHeaders()
+	h["Accept"] = "text/html, */*; q=0.01"
+	h["X-Requested-With"] = "XMLHttpRequest"
+	if hexinV != "" {
+		h["hexin-v"] = hexinV
+		h["Cookie"] = "v=" + hexinV
+	}
+	return h
+}
+
 // GetTencentHeaders 腾讯财经请求头
 func GetTencentHeaders() map[string]string {
 	return map[string]string{
@@ -90,6 +102,17 @@ func GetTencentHeaders() map[string]string {
 	}
 }
 
+// GetSSEHeaders 上交所查询接口请求头，接口校验 Referer
+func GetSSEHeaders() map[string]string {
+	return map[string]string{
+		"User-Agent":      GetRandomUserAgent(),
+		"Accept":          "*/*",
+		"Accept-Language": "zh-CN,zh;q=0.9",
+		"Connection":      "keep-alive",
+		"Referer":         "https://www.sse.com.cn/",
+	}
+}
+
 // GetCommonHeaders 获取通用请求头
 func GetCommonHeaders() map[string]string {
 	return map[string]string{
@@ -0,0 +1,204 @@
+// Package symbol 提供证券代码的统一解析、品种分类和各数据源格式转换
+package symbol
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+)
+
+// 交易所代码
+const (
+	ExchangeSH = "SH" // 上海证券交易所
+	ExchangeSZ = "SZ" // 深圳证券交易所
+	ExchangeBJ = "BJ" // 北京证券交易所
+)
+
+// InstrumentType 证券品种
+type InstrumentType string
+
+// 证券品种
+const (
+	InstrumentAShare      InstrumentType = "A股"
+	InstrumentBShare      InstrumentType = "B股"
+	InstrumentETF         InstrumentType = "ETF"
+	InstrumentLOF         InstrumentType = "LOF"
+	InstrumentConvertible InstrumentType = "可转债"
+	InstrumentIndex       InstrumentType = "指数"
+	InstrumentBSE         InstrumentType = "北交所"
+	InstrumentUnknown     InstrumentType = "未知"
+)
+
+// Symbol 带交易所的证券代码
+// 同一个6位代码在不同交易所可能是不同品种，如 SH000001 为上证指数、SZ000001 为平安银行
+type Symbol struct {
+	Code     string         `json:"code"`     // 6位证券代码
+	Exchange string         `json:"exchange"` // 交易所：SH、SZ、BJ
+	Type     InstrumentType `json:"type"`     // 证券品种
+}
+
+// Parse 解析证券代码，支持 600000、sh600000、SH600000、600000.SH、1.600000 等格式
+// 不带交易所的6位代码按股票、基金、可转债的代码规则推断交易所，000001 解析为深市平安银行；
+// 上证指数等与股票代码重叠的指数需显式带上交易所，如 sh000001
+func Parse(s string) (Symbol, error) {
+	raw := strings.TrimSpace(s)
+	upper := strings.ToUpper(raw)
+
+	var code, exchange string
+	switch {
+	case len(upper) == 6:
+		code = upper
+	case len(upper) == 8 && isExchange(upper[:2]):
+		exchange, code = upper[:2], upper[2:]
+	case len(upper) == 9 && upper[6] == '.' && isExchange(upper[7:]):
+		code, exchange = upper[:6], upper[7:]
+	case len(upper) == 8 && upper[1] == '.':
+		// 东方财富 secid：1 为上交所，0 为深交所和北交所
+		code = upper[2:]
+		switch upper[0] {
+		case '1':
+			exchange = ExchangeSH
+		case '0':
+			exchange = ExchangeSZ
+			if inferExchange(code) == ExchangeBJ {
+				exchange = ExchangeBJ
+			}
+		}
+		if exchange == "" {
+			return Symbol{}, invalidSymbol(raw)
+		}
+	default:
+		return Symbol{}, invalidSymbol(raw)
+	}
+
+	if _, err := strconv.Atoi(code); err != nil {
+		return Symbol{}, invalidSymbol(raw)
+	}
+
+	if exchange == "" {
+		exchange = inferExchange(code)
+		if exchange == "" {
+			return Symbol{}, invalidSymbol(raw)
+		}
+	}
+
+	return Symbol{
+		Code:     code,
+		Exchange: exchange,
+		Type:     classify(code, exchange),
+	}, nil
+}
+
+// String 返回带后缀的代码，如 600000.SH
+func (s Symbol) String() string {
+	return s.Code + "." + s.Exchange
+}
+
+// Prefixed 返回小写交易所前缀的代码，如 sh600000，用于新浪、腾讯
+func (s Symbol) Prefixed() string {
+	return strings.ToLower(s.Exchange) + s.Code
+}
+
+// SecID 返回东方财富 secid，如 1.600000、0.000001
+func (s Symbol) SecID() string {
+	if s.Exchange == ExchangeSH {
+		return "1." + s.Code
+	}
+	return "0." + s.Code
+}
+
+// IsStock 是否为股票（A股、B股、北交所股票）
+func (s Symbol) IsStock() bool {
+	return s.Type == InstrumentAShare || s.Type == InstrumentBShare || s.Type == InstrumentBSE
+}
+
+// Board 返回股票所属板块：主板、创业板、科创板、北交所，非股票返回空字符串
+func (s Symbol) Board() string {
+	switch {
+	case s.Type == InstrumentBSE:
+		return "北交所"
+	case s.Type != InstrumentAShare && s.Type != InstrumentBShare:
+		return ""
+	case strings.HasPrefix(s.Code, "30"):
+		return "创业板"
+	case strings.HasPrefix(s.Code, "68"):
+		return "科创板"
+	default:
+		return "主板"
+	}
+}
+
+// inferExchange 根据6位代码推断交易所，指数与股票代码重叠时按股票处理
+func inferExchange(code string) string {
+	switch {
+	case strings.HasPrefix(code, "92") || strings.HasPrefix(code, "899"):
+		return ExchangeBJ
+	case code[0] == '6' || code[0] == '9' || code[0] == '5' || strings.HasPrefix(code, "11"):
+		return ExchangeSH
+	case code[0] == '4' || code[0] == '8':
+		return ExchangeBJ
+	case code[0] == '0' || code[0] == '1' || code[0] == '2' || code[0] == '3':
+		return ExchangeSZ
+	default:
+		return ""
+	}
+}
+
+// classify 根据交易所和代码前缀判断证券品种
+func classify(code, exchange string) InstrumentType {
+	p1, p2, p3 := code[:1], code[:2], code[:3]
+
+	switch exchange {
+	case ExchangeSH:
+		switch {
+		case p3 == "600" || p3 == "601" || p3 == "603" || p3 == "605" || p3 == "688" || p3 == "689":
+			return InstrumentAShare
+		case p3 == "900":
+			return InstrumentBShare
+		case p3 == "000":
+			return InstrumentIndex
+		case p3 == "501" || p3 == "502" || p3 == "506":
+			return InstrumentLOF
+		case p2 == "51" || p2 == "52" || p2 == "56" || p2 == "58":
+			return InstrumentETF
+		case p3 == "110" || p3 == "111" || p3 == "113" || p3 == "118":
+			return InstrumentConvertible
+		}
+	case ExchangeSZ:
+		switch {
+		case p2 == "00" || p2 == "30":
+			return InstrumentAShare
+		case p2 == "20":
+			return InstrumentBShare
+		case p3 == "399":
+			return InstrumentIndex
+		case p3 == "159":
+			return InstrumentETF
+		case p2 == "16":
+			return InstrumentLOF
+		case p3 == "123" || p3 == "127" || p3 == "128":
+			return InstrumentConvertible
+		}
+	case ExchangeBJ:
+		switch {
+		case p3 == "899":
+			return InstrumentIndex
+		case p1 == "4" || p1 == "8" || p2 == "92":
+			return InstrumentBSE
+		}
+	}
+
+	return InstrumentUnknown
+}
+
+// isExchange 是否为支持的交易所代码
+func isExchange(exchange string) bool {
+	return exchange == ExchangeSH || exchange == ExchangeSZ || exchange == ExchangeBJ
+}
+
+// invalidSymbol 构造证券代码解析错误
+func invalidSymbol(s string) error {
+	return errors.NewADataError(errors.ErrInvalidStockCode.Code, "无法解析证券代码", fmt.Sprintf("%q", s))
+}
@@ -3,10 +3,13 @@ package utils
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/common/symbol"
 )
 
 // ExchangeSuffix 交易所后缀映射
@@ -23,32 +26,22 @@ var ExchangeSuffix = map[string]string{
 	"92": ".BJ", // 北交所
 }
 
-// GetExchangeByStockCode 根据股票代码获取交易所
+// GetExchangeByStockCode 根据股票代码获取交易所，代码规则见 symbol.Parse
 func GetExchangeByStockCode(stockCode string) string {
-	if len(stockCode) < 2 {
+	sym, err := symbol.Parse(stockCode)
+	if err != nil {
 		return "UNKNOWN"
 	}
-
-	prefix := stockCode[:2]
-	if suffix, exists := ExchangeSuffix[prefix]; exists {
-		return suffix[1:] // 去掉点号，只返回交易所代码
-	}
-
-	return "UNKNOWN"
+	return sym.Exchange
 }
 
-// CompileExchangeByStockCode 根据股票代码补全市场后缀
+// CompileExchangeByStockCode 根据股票代码补全市场后缀，无法解析时原样返回
 func CompileExchangeByStockCode(stockCode string) string {
-	if len(stockCode) < 2 {
+	sym, err := symbol.Parse(stockCode)
+	if err != nil {
 		return stockCode
 	}
-
-	prefix := stockCode[:2]
-	if suffix, exists := ExchangeSuffix[prefix]; exists {
-		return stockCode + suffix
-	}
-
-	return stockCode
+	return sym.String()
 }
 
 // IsValidStockCode 验证股票代码是否有效
@@ -68,6 +61,61 @@ func IsValidStockCode(stockCode string) bool {
 	return exists
 }
 
+// GetIndexExchange 根据指数代码推断所属交易所
+// 000 开头为上交所指数，399 开头为深交所指数，899 开头为北交所指数，无法判断时返回空字符串
+func GetIndexExchange(indexCode string) string {
+	if len(indexCode) != 6 {
+		return ""
+	}
+
+	switch indexCode[:3] {
+	case "000":
+		return "SH"
+	case "399":
+		return "SZ"
+	case "899":
+		return "BJ"
+	default:
+		return ""
+	}
+}
+
+// boardCodeRe 东方财富板块代码格式，如 BK0493
+var boardCodeRe = regexp.MustCompile(`^BK\d{4}$`)
+
+// IsValidBoardCode 验证东方财富概念、行业板块代码是否有效，不区分大小写
+func IsValidBoardCode(boardCode string) bool {
+	return boardCodeRe.MatchString(strings.ToUpper(boardCode))
+}
+
+// GetLimitRatio 根据股票代码和是否ST获取涨跌幅限制比例
+// 北交所30%，创业板、科创板20%（含ST），主板ST为5%，其余主板为10%
+func GetLimitRatio(stockCode string, isST bool) float64 {
+	switch {
+	case GetExchangeByStockCode(stockCode) == "BJ":
+		return 0.30
+	case strings.HasPrefix(stockCode, "30"), strings.HasPrefix(stockCode, "68"):
+		return 0.20
+	case isST:
+		return 0.05
+	default:
+		return 0.10
+	}
+}
+
+// IsSTName 根据股票简称判断是否为ST或*ST股票
+func IsSTName(shortName string) bool {
+	return strings.Contains(strings.ToUpper(shortName), "ST")
+}
+
+// GetLimitPrice 根据昨收价和涨跌幅限制比例计算涨停价和跌停价，四舍五入到分
+func GetLimitPrice(preClose, ratio float64) (float64, float64) {
+	// 加上极小值避免浮点误差导致的舍入偏差，如 10.05*1.1=11.054999...
+	up := math.Floor(preClose*(1+ratio)*100+0.5+1e-6) / 100
+	down := math.Floor(preClose*(1-ratio)*100+0.5+1e-6) / 100
+	return up, down
+}
+
 // FormatDate 格式化日期字符串
 func FormatDate(date string) (string, error) {
 	if date == "" {
@@ -131,6 +179,18 @@ func ParseFloat(s string) float64 {
 	return 0.0
 }
 
+// ToFloat 将接口返回的数值或字符串字段转换为浮点数，如东方财富停牌股票的 "-"
+func ToFloat(v interface{}) float64 {
+	switch value := v.(type) {
+	case float64:
+		return value
+	case string:
+		return ParseFloat(value)
+	default:
+		return 0
+	}
+}
+
 // ParseInt 安全解析整数
 func ParseInt(s string) int64 {
 	if s == "" || s == "-" || s == "--" {
@@ -159,10 +219,13 @@ func CleanString(s string) string {
 	return s
 }
 
-// FormatStockCode 格式化股票代码为6位数字
+// FormatStockCode 格式化股票代码为6位数字，可由 symbol.Parse 解析的代码直接取其6位代码，
+// 其余输入取第一段数字并补齐到6位
 func FormatStockCode(code string) string {
-	// 移除可能的前缀和后缀
 	code = strings.TrimSpace(code)
+	if sym, err := symbol.Parse(code); err == nil {
+		return sym.Code
+	}
 
 	// 提取数字部分
 	re := regexp.MustCompile(`\d+`)
@@ -197,9 +260,11 @@ func ConvertUnits(value float64, unit string) float64 {
 	}
 }
 
-// IsMarketOpen 检查当前是否为交易时间
+// IsMarketOpen 检查当前是否为连续竞价时间，按北京时间判断，不考虑节假日和集合竞价
+//
+// Deprecated: 请使用 calendar.Clock，其结合交易日历并区分集合竞价、午间休市和盘后交易
 func IsMarketOpen() bool {
-	now := time.Now()
+	now := time.Now().In(time.FixedZone("Asia/Shanghai", 8*60*60))
 
 	// 检查是否为周末
 	if now.Weekday() == time.Saturday || now.Weekday() == time.Sunday {
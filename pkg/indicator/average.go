@@ -0,0 +1,171 @@
+package indicator
+
+import "math"
+
+// MAStream 简单移动平均 MA(X, N)，前 N-1 个值为 NaN
+type MAStream struct {
+	window *window
+}
+
+// NewMAStream 创建简单移动平均
+func NewMAStream(n int) *MAStream {
+	return &MAStream{window: newWindow(n)}
+}
+
+// Next 输入新值并返回当前均值
+func (s *MAStream) Next(x float64) float64 {
+	s.window.push(x)
+	if !s.window.full() {
+		return math.NaN()
+	}
+	return s.window.mean()
+}
+
+// MA 计算简单移动平均
+func MA(values []float64, n int) []float64 {
+	stream := NewMAStream(n)
+	result := make([]float64, len(values))
+	for i, v := range values {
+		result[i] = stream.Next(v)
+	}
+	return result
+}
+
+// EMAStream 指数移动平均 EMA(X, N) = (2 × X + (N - 1) × 前值) / (N + 1)，首值取 X
+type EMAStream struct {
+	n       int
+	value   float64
+	started bool
+}
+
+// NewEMAStream 创建指数移动平均
+func NewEMAStream(n int) *EMAStream {
+	return &EMAStream{n: n}
+}
+
+// Next 输入新值并返回当前均值
+func (s *EMAStream) Next(x float64) float64 {
+	if !s.started {
+		s.value, s.started = x, true
+		return s.value
+	}
+	s.value = (2*x + float64(s.n-1)*s.value) / float64(s.n+1)
+	return s.value
+}
+
+// EMA 计算指数移动平均
+func EMA(values []float64, n int) []float64 {
+	stream := NewEMAStream(n)
+	result := make([]float64, len(values))
+	for i, v := range values {
+		result[i] = stream.Next(v)
+	}
+	return result
+}
+
+// SMAStream 国内行情软件的 SMA(X, N, M) = (M × X + (N - M) × 前值) / N，首值取 X
+type SMAStream struct {
+	n, m    int
+	value   float64
+	started bool
+}
+
+// NewSMAStream 创建 SMA 平滑，M 为权重，需小于 N
+func NewSMAStream(n, m int) *SMAStream {
+	return &SMAStream{n: n, m: m}
+}
+
+// Next 输入新值并返回当前平滑值
+func (s *SMAStream) Next(x float64) float64 {
+	if !s.started {
+		s.value, s.started = x, true
+		return s.value
+	}
+	s.value = (float64(s.m)*x + float64(s.n-s.m)*s.value) / float64(s.n)
+	return s.value
+}
+
+// SMA 计算 SMA 平滑
+func SMA(values []float64, n, m int) []float64 {
+	stream := NewSMAStream(n, m)
+	result := make([]float64, len(values))
+	for i, v := range values {
+		result[i] = stream.Next(v)
+	}
+	return result
+}
+
+// BIASStream 乖离率 BIAS = (C - MA(C, N)) / MA(C, N) × 100
+type BIASStream struct {
+	ma *MAStream
+}
+
+// NewBIASStream 创建乖离率，常用参数 6、12、24
+func NewBIASStream(n int) *BIASStream {
+	return &BIASStream{ma: NewMAStream(n)}
+}
+
+// Next 输入收盘价并返回当前乖离率
+func (s *BIASStream) Next(close float64) float64 {
+	ma := s.ma.Next(close)
+	return (close - ma) / ma * 100
+}
+
+// BIAS 计算乖离率
+func BIAS(closes []float64, n int) []float64 {
+	stream := NewBIASStream(n)
+	result := make([]float64, len(closes))
+	for i, v := range closes {
+		result[i] = stream.Next(v)
+	}
+	return result
+}
+
+// BOLLResult 布林线计算结果
+type BOLLResult struct {
+	Mid   []float64 // 中轨
+	Upper []float64 // 上轨
+	Lower []float64 // 下轨
+}
+
+// BOLLStream 布林线：中轨 MA(C, N)，上下轨为中轨 ± K × STD(C, N)，STD 为样本标准差
+type BOLLStream struct {
+	window *window
+	k      float64
+}
+
+// NewBOLLStream 创建布林线，常用参数 N=20、K=2
+func NewBOLLStream(n int, k float64) *BOLLStream {
+	return &BOLLStream{window: newWindow(n), k: k}
+}
+
+// Next 输入收盘价并返回中轨、上轨、下轨
+func (s *BOLLStream) Next(close float64) (mid, upper, lower float64) {
+	s.window.push(close)
+	if !s.window.full() || len(s.window.values) < 2 {
+		return math.NaN(), math.NaN(), math.NaN()
+	}
+
+	mid = s.window.mean()
+	variance := 0.0
+	for _, v := range s.window.values {
+		variance += (v - mid) * (v - mid)
+	}
+	std := math.Sqrt(variance / float64(len(s.window.values)-1))
+
+	return mid, mid + s.k*std, mid - s.k*std
+}
+
+// BOLL 计算布林线
+func BOLL(closes []float64, n int, k float64) BOLLResult {
+	stream := NewBOLLStream(n, k)
+	result := BOLLResult{
+		Mid:   make([]float64, len(closes)),
+		Upper: make([]float64, len(closes)),
+		Lower: make([]float64, len(closes)),
+	}
+	for i, v := range closes {
+		result.Mid[i], result.Upper[i], result.Lower[i] = stream.Next(v)
+	}
+	return result
+}
@@ -0,0 +1,108 @@
+// Package indicator 提供基于K线的技术指标计算，计算口径与通达信、同花顺等国内行情软件一致
+//
+// 每个指标均提供流式和批量两种用法：流式计算通过 NewXXXStream 创建，逐根K线调用 Next；
+// 批量计算直接传入收盘价序列或 []types.MarketData，返回与输入等长的结果。
+//
+// 数据不足时的取值与通达信一致：MA、BIAS、BOLL、CCI、ATR、DMI 及指定周期的 VWAP 等固定窗口指标
+// 在窗口填满前为 NaN，RSI 首根K线为 NaN；EMA、SMA、MACD、KDJ、WR、OBV 等递推或累计指标
+// 自首根K线起即有值（EMA、SMA 首值取输入值，KDJ 的 K、D 初始值为 50，WR 不足 N 根时按已有K线计算），
+// 前若干根的结果受初始值影响较大，使用时应自行跳过预热区间
+package indicator
+
+import (
+	"math"
+
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// Closes 提取收盘价序列
+func Closes(bars []types.MarketData) []float64 {
+	values := make([]float64, len(bars))
+	for i, bar := range bars {
+		values[i] = bar.Close
+	}
+	return values
+}
+
+// Highs 提取最高价序列
+func Highs(bars []types.MarketData) []float64 {
+	values := make([]float64, len(bars))
+	for i, bar := range bars {
+		values[i] = bar.High
+	}
+	return values
+}
+
+// Lows 提取最低价序列
+func Lows(bars []types.MarketData) []float64 {
+	values := make([]float64, len(bars))
+	for i, bar := range bars {
+		values[i] = bar.Low
+	}
+	return values
+}
+
+// Volumes 提取成交量序列
+func Volumes(bars []types.MarketData) []float64 {
+	values := make([]float64, len(bars))
+	for i, bar := range bars {
+		values[i] = float64(bar.Volume)
+	}
+	return values
+}
+
+// window 固定长度的滑动窗口
+type window struct {
+	size   int
+	values []float64
+}
+
+// newWindow 创建滑动窗口，size 小于等于0时不限长度
+func newWindow(size int) *window {
+	return &window{size: size}
+}
+
+// push 追加一个值，超出长度时移除最早的值
+func (w *window) push(x float64) {
+	w.values = append(w.values, x)
+	if w.size > 0 && len(w.values) > w.size {
+		w.values = w.values[1:]
+	}
+}
+
+// full 判断窗口是否已满
+func (w *window) full() bool {
+	return w.size > 0 && len(w.values) == w.size
+}
+
+// sum 窗口内求和
+func (w *window) sum() float64 {
+	total := 0.0
+	for _, v := range w.values {
+		total += v
+	}
+	return total
+}
+
+// mean 窗口内均值
+func (w *window) mean() float64 {
+	return w.sum() / float64(len(w.values))
+}
+
+// max 窗口内最大值
+func (w *window) max() float64 {
+	result := math.Inf(-1)
+	for _, v := range w.values {
+		result = math.Max(result, v)
+	}
+	return result
+}
+
+// min 窗口内最小值
+func (w *window) min() float64 {
+	result := math.Inf(1)
+	for _, v := range w.values {
+		result = math.Min(result, v)
+	}
+	return result
+}
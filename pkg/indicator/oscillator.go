@@ -0,0 +1,223 @@
+package indicator
+
+import (
+	"math"
+
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// MACDResult MACD 计算结果
+type MACDResult struct {
+	DIF  []float64 // 快线
+	DEA  []float64 // 慢线
+	MACD []float64 // 柱，2 × (DIF - DEA)
+}
+
+// MACDStream MACD：DIF = EMA(C, SHORT) - EMA(C, LONG)，DEA = EMA(DIF, MID)，MACD = 2 × (DIF - DEA)
+type MACDStream struct {
+	short, long, dea *EMAStream
+}
+
+// NewMACDStream 创建 MACD，常用参数 12、26、9
+func NewMACDStream(short, long, mid int) *MACDStream {
+	return &MACDStream{
+		short: NewEMAStream(short),
+		long:  NewEMAStream(long),
+		dea:   NewEMAStream(mid),
+	}
+}
+
+// Next 输入收盘价并返回 DIF、DEA、MACD
+func (s *MACDStream) Next(close float64) (dif, dea, macd float64) {
+	dif = s.short.Next(close) - s.long.Next(close)
+	dea = s.dea.Next(dif)
+	return dif, dea, 2 * (dif - dea)
+}
+
+// MACD 计算 MACD
+func MACD(closes []float64, short, long, mid int) MACDResult {
+	stream := NewMACDStream(short, long, mid)
+	result := MACDResult{
+		DIF:  make([]float64, len(closes)),
+		DEA:  make([]float64, len(closes)),
+		MACD: make([]float64, len(closes)),
+	}
+	for i, v := range closes {
+		result.DIF[i], result.DEA[i], result.MACD[i] = stream.Next(v)
+	}
+	return result
+}
+
+// RSIStream 相对强弱指标 RSI = SMA(MAX(C - LC, 0), N, 1) / SMA(ABS(C - LC), N, 1) × 100，LC 为前收盘价
+// 首根K线没有前收盘价，结果为 NaN
+type RSIStream struct {
+	up, abs *SMAStream
+	prev    float64
+	started bool
+}
+
+// NewRSIStream 创建 RSI，常用参数 6、12、24
+func NewRSIStream(n int) *RSIStream {
+	return &RSIStream{up: NewSMAStream(n, 1), abs: NewSMAStream(n, 1)}
+}
+
+// Next 输入收盘价并返回当前 RSI
+func (s *RSIStream) Next(close float64) float64 {
+	if !s.started {
+		s.prev, s.started = close, true
+		return math.NaN()
+	}
+
+	diff := close - s.prev
+	s.prev = close
+
+	up := s.up.Next(math.Max(diff, 0))
+	abs := s.abs.Next(math.Abs(diff))
+	if abs == 0 {
+		return math.NaN()
+	}
+	return up / abs * 100
+}
+
+// RSI 计算 RSI
+func RSI(closes []float64, n int) []float64 {
+	stream := NewRSIStream(n)
+	result := make([]float64, len(closes))
+	for i, v := range closes {
+		result[i] = stream.Next(v)
+	}
+	return result
+}
+
+// KDJResult KDJ 计算结果
+type KDJResult struct {
+	K []float64
+	D []float64
+	J []float64
+}
+
+// KDJStream 随机指标：RSV = (C - LLV(L, N)) / (HHV(H, N) - LLV(L, N)) × 100，
+// K = SMA(RSV, M1, 1)，D = SMA(K, M2, 1)，J = 3K - 2D；K、D 初始值为 50，不足 N 根时按已有K线计算
+// 最高价等于最低价时 RSV 取 50
+type KDJStream struct {
+	highs, lows *window
+	m1, m2      float64
+	k, d        float64
+}
+
+// NewKDJStream 创建 KDJ，常用参数 9、3、3
+func NewKDJStream(n, m1, m2 int) *KDJStream {
+	return &KDJStream{
+		highs: newWindow(n),
+		lows:  newWindow(n),
+		m1:    float64(m1),
+		m2:    float64(m2),
+		k:     50,
+		d:     50,
+	}
+}
+
+// Next 输入K线并返回 K、D、J
+func (s *KDJStream) Next(bar types.MarketData) (k, d, j float64) {
+	s.highs.push(bar.High)
+	s.lows.push(bar.Low)
+
+	rsv := 50.0
+	hhv, llv := s.highs.max(), s.lows.min()
+	if hhv > llv {
+		rsv = (bar.Close - llv) / (hhv - llv) * 100
+	}
+
+	s.k = (rsv + (s.m1-1)*s.k) / s.m1
+	s.d = (s.k + (s.m2-1)*s.d) / s.m2
+	return s.k, s.d, 3*s.k - 2*s.d
+}
+
+// KDJ 计算 KDJ
+func KDJ(bars []types.MarketData, n, m1, m2 int) KDJResult {
+	stream := NewKDJStream(n, m1, m2)
+	result := KDJResult{
+		K: make([]float64, len(bars)),
+		D: make([]float64, len(bars)),
+		J: make([]float64, len(bars)),
+	}
+	for i, bar := range bars {
+		result.K[i], result.D[i], result.J[i] = stream.Next(bar)
+	}
+	return result
+}
+
+// WRStream 威廉指标 WR = (HHV(H, N) - C) / (HHV(H, N) - LLV(L, N)) × 100，不足 N 根时按已有K线计算
+// 最高价等于最低价时取 50
+type WRStream struct {
+	highs, lows *window
+}
+
+// NewWRStream 创建威廉指标，常用参数 10、6
+func NewWRStream(n int) *WRStream {
+	return &WRStream{highs: newWindow(n), lows: newWindow(n)}
+}
+
+// Next 输入K线并返回当前 WR
+func (s *WRStream) Next(bar types.MarketData) float64 {
+	s.highs.push(bar.High)
+	s.lows.push(bar.Low)
+
+	hhv, llv := s.highs.max(), s.lows.min()
+	if hhv == llv {
+		return 50
+	}
+	return (hhv - bar.Close) / (hhv - llv) * 100
+}
+
+// WR 计算威廉指标
+func WR(bars []types.MarketData, n int) []float64 {
+	stream := NewWRStream(n)
+	result := make([]float64, len(bars))
+	for i, bar := range bars {
+		result[i] = stream.Next(bar)
+	}
+	return result
+}
+
+// CCIStream 顺势指标 CCI = (TYP - MA(TYP, N)) / (0.015 × AVEDEV(TYP, N))，TYP = (H + L + C) / 3
+// 前 N-1 个值为 NaN，平均绝对偏差为0时取0
+type CCIStream struct {
+	window *window
+}
+
+// NewCCIStream 创建 CCI，常用参数 14
+func NewCCIStream(n int) *CCIStream {
+	return &CCIStream{window: newWindow(n)}
+}
+
+// Next 输入K线并返回当前 CCI
+func (s *CCIStream) Next(bar types.MarketData) float64 {
+	typ := (bar.High + bar.Low + bar.Close) / 3
+	s.window.push(typ)
+	if !s.window.full() {
+		return math.NaN()
+	}
+
+	mean := s.window.mean()
+	dev := 0.0
+	for _, v := range s.window.values {
+		dev += math.Abs(v - mean)
+	}
+	dev /= float64(len(s.window.values))
+
+	if dev == 0 {
+		return 0
+	}
+	return (typ - mean) / (0.015 * dev)
+}
+
+// CCI 计算顺势指标
+func CCI(bars []types.MarketData, n int) []float64 {
+	stream := NewCCIStream(n)
+	result := make([]float64, len(bars))
+	for i, bar := range bars {
+		result[i] = stream.Next(bar)
+	}
+	return result
+}
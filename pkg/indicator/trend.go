@@ -0,0 +1,146 @@
+package indicator
+
+import (
+	"math"
+
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// ATRStream 真实波幅均值：TR = MAX(H - L, ABS(LC - H), ABS(LC - L))，ATR = MA(TR, N)
+// 首根K线没有前收盘价，TR 取 H - L
+type ATRStream struct {
+	ma      *MAStream
+	prev    float64
+	started bool
+}
+
+// NewATRStream 创建 ATR，常用参数 14
+func NewATRStream(n int) *ATRStream {
+	return &ATRStream{ma: NewMAStream(n)}
+}
+
+// Next 输入K线并返回当前 ATR
+func (s *ATRStream) Next(bar types.MarketData) float64 {
+	tr := bar.High - bar.Low
+	if s.started {
+		tr = trueRange(bar, s.prev)
+	}
+	s.prev, s.started = bar.Close, true
+	return s.ma.Next(tr)
+}
+
+// ATR 计算真实波幅均值
+func ATR(bars []types.MarketData, n int) []float64 {
+	stream := NewATRStream(n)
+	result := make([]float64, len(bars))
+	for i, bar := range bars {
+		result[i] = stream.Next(bar)
+	}
+	return result
+}
+
+// DMIResult DMI 计算结果
+type DMIResult struct {
+	PDI  []float64 // 上升方向线
+	MDI  []float64 // 下降方向线
+	ADX  []float64 // 趋向平均值
+	ADXR []float64 // 趋向平均值评估
+}
+
+// DMIStream 趋向指标：
+// MTR = SUM(TR, N)，HD = H - REF(H, 1)，LD = REF(L, 1) - L，
+// DMP = SUM(IF(HD > 0 且 HD > LD, HD, 0), N)，DMM = SUM(IF(LD > 0 且 LD > HD, LD, 0), N)，
+// PDI = DMP × 100 / MTR，MDI = DMM × 100 / MTR，
+// ADX = MA(ABS(MDI - PDI) / (MDI + PDI) × 100, M)，ADXR = (ADX + REF(ADX, M)) / 2
+type DMIStream struct {
+	m                 int
+	tr, dmp, dmm      *window
+	adx               *MAStream
+	adxHistory        *window
+	prevHigh, prevLow float64
+	prevClose         float64
+	started           bool
+}
+
+// NewDMIStream 创建 DMI，常用参数 14、6
+func NewDMIStream(n, m int) *DMIStream {
+	return &DMIStream{
+		m:          m,
+		tr:         newWindow(n),
+		dmp:        newWindow(n),
+		dmm:        newWindow(n),
+		adx:        NewMAStream(m),
+		adxHistory: newWindow(m + 1),
+	}
+}
+
+// Next 输入K线并返回 PDI、MDI、ADX、ADXR
+func (s *DMIStream) Next(bar types.MarketData) (pdi, mdi, adx, adxr float64) {
+	nan := math.NaN()
+	if !s.started {
+		s.prevHigh, s.prevLow, s.prevClose, s.started = bar.High, bar.Low, bar.Close, true
+		return nan, nan, nan, nan
+	}
+
+	hd := bar.High - s.prevHigh
+	ld := s.prevLow - bar.Low
+
+	s.tr.push(trueRange(bar, s.prevClose))
+	s.dmp.push(directionalMove(hd, ld))
+	s.dmm.push(directionalMove(ld, hd))
+	s.prevHigh, s.prevLow, s.prevClose = bar.High, bar.Low, bar.Close
+
+	if !s.tr.full() {
+		return nan, nan, nan, nan
+	}
+
+	mtr := s.tr.sum()
+	if mtr > 0 {
+		pdi = s.dmp.sum() * 100 / mtr
+		mdi = s.dmm.sum() * 100 / mtr
+	}
+
+	dx := 0.0
+	if pdi+mdi > 0 {
+		dx = math.Abs(mdi-pdi) / (mdi + pdi) * 100
+	}
+
+	adx = s.adx.Next(dx)
+	if math.IsNaN(adx) {
+		return pdi, mdi, nan, nan
+	}
+
+	s.adxHistory.push(adx)
+	if !s.adxHistory.full() {
+		return pdi, mdi, adx, nan
+	}
+	return pdi, mdi, adx, (adx + s.adxHistory.values[0]) / 2
+}
+
+// DMI 计算趋向指标
+func DMI(bars []types.MarketData, n, m int) DMIResult {
+	stream := NewDMIStream(n, m)
+	result := DMIResult{
+		PDI:  make([]float64, len(bars)),
+		MDI:  make([]float64, len(bars)),
+		ADX:  make([]float64, len(bars)),
+		ADXR: make([]float64, len(bars)),
+	}
+	for i, bar := range bars {
+		result.PDI[i], result.MDI[i], result.ADX[i], result.ADXR[i] = stream.Next(bar)
+	}
+	return result
+}
+
+// trueRange 计算真实波幅
+func trueRange(bar types.MarketData, prevClose float64) float64 {
+	return math.Max(bar.High-bar.Low, math.Max(math.Abs(prevClose-bar.High), math.Abs(prevClose-bar.Low)))
+}
+
+// directionalMove 计算单向动向值：本方向变动为正且大于反方向时取本方向变动，否则为0
+func directionalMove(move, opposite float64) float64 {
+	if move > 0 && move > opposite {
+		return move
+	}
+	return 0
+}
@@ -0,0 +1,83 @@
+package indicator
+
+import (
+	"math"
+
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// OBVStream 能量潮：收盘价上涨累加成交量，下跌累减成交量，持平不变，首根K线为0
+type OBVStream struct {
+	value   float64
+	prev    float64
+	started bool
+}
+
+// NewOBVStream 创建能量潮
+func NewOBVStream() *OBVStream {
+	return &OBVStream{}
+}
+
+// Next 输入K线并返回当前 OBV
+func (s *OBVStream) Next(bar types.MarketData) float64 {
+	if s.started {
+		switch {
+		case bar.Close > s.prev:
+			s.value += float64(bar.Volume)
+		case bar.Close < s.prev:
+			s.value -= float64(bar.Volume)
+		}
+	}
+	s.prev, s.started = bar.Close, true
+	return s.value
+}
+
+// OBV 计算能量潮
+func OBV(bars []types.MarketData) []float64 {
+	stream := NewOBVStream()
+	result := make([]float64, len(bars))
+	for i, bar := range bars {
+		result[i] = stream.Next(bar)
+	}
+	return result
+}
+
+// VWAPStream 成交量加权均价 VWAP = SUM(TYP × V, N) / SUM(V, N)，TYP = (H + L + C) / 3
+// 不同数据源成交量单位（股、手）不一致，因此不使用成交额计算；成交量合计为0时为 NaN
+type VWAPStream struct {
+	weighted, volumes *window
+}
+
+// NewVWAPStream 创建成交量加权均价，n 小于等于0时自首根K线起累计
+func NewVWAPStream(n int) *VWAPStream {
+	return &VWAPStream{weighted: newWindow(n), volumes: newWindow(n)}
+}
+
+// Next 输入K线并返回当前 VWAP
+func (s *VWAPStream) Next(bar types.MarketData) float64 {
+	typ := (bar.High + bar.Low + bar.Close) / 3
+	volume := float64(bar.Volume)
+
+	s.weighted.push(typ * volume)
+	s.volumes.push(volume)
+
+	if s.weighted.size > 0 && !s.weighted.full() {
+		return math.NaN()
+	}
+
+	total := s.volumes.sum()
+	if total == 0 {
+		return math.NaN()
+	}
+	return s.weighted.sum() / total
+}
+
+// VWAP 计算成交量加权均价
+func VWAP(bars []types.MarketData, n int) []float64 {
+	stream := NewVWAPStream(n)
+	result := make([]float64, len(bars))
+	for i, bar := range bars {
+		result[i] = stream.Next(bar)
+	}
+	return result
+}
@@ -0,0 +1,72 @@
+package sentiment
+
+import (
+	"fmt"
+
+	"github.com/onepiecelover/adata-go/pkg/common/eastmoney"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// GetBlockTrade 获取大宗交易明细，stockCode 为空时返回全市场，日期为空时默认当天
+func (s *Sentiment) GetBlockTrade(stockCode, startDate, endDate string) ([]types.BlockTrade, error) {
+	if stockCode != "" && !utils.IsValidStockCode(stockCode) {
+		return nil, errors.ErrInvalidStockCode
+	}
+
+	start, end, err := normalizeDateRange(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// SECURITY_TYPE_WEB=1 仅保留A股
+	filter := fmt.Sprintf("(SECURITY_TYPE_WEB=1)(TRADE_DATE>='%s')(TRADE_DATE<='%s')", start, end)
+	if stockCode != "" {
+		filter += fmt.Sprintf(`(SECURITY_CODE="%s")`, stockCode)
+	}
+
+	query := eastmoney.Query{
+		ReportName:  "RPT_DATA_BLOCKTRADE",
+		Columns:     "TRADE_DATE,SECURITY_CODE,SECURITY_NAME_ABBR,CHANGE_RATE,CLOSE_PRICE,DEAL_PRICE,PREMIUM_RATIO,DEAL_VOLUME,DEAL_AMT,BUYER_NAME,SELLER_NAME",
+		Filter:      filter,
+		SortColumns: "TRADE_DATE,SECURITY_CODE",
+		SortTypes:   "-1,1",
+	}
+
+	rows, err := eastmoney.FetchAll[struct {
+		TradeDate        string  `json:"TRADE_DATE"`
+		SecurityCode     string  `json:"SECURITY_CODE"`
+		SecurityNameAbbr string  `json:"SECURITY_NAME_ABBR"`
+		ChangeRate       float64 `json:"CHANGE_RATE"`
+		ClosePrice       float64 `json:"CLOSE_PRICE"`
+		DealPrice        float64 `json:"DEAL_PRICE"`
+		PremiumRatio     float64 `json:"PREMIUM_RATIO"`
+		DealVolume       float64 `json:"DEAL_VOLUME"`
+		DealAmt          float64 `json:"DEAL_AMT"`
+		BuyerName        string  `json:"BUYER_NAME"`
+		SellerName       string  `json:"SELLER_NAME"`
+	}](s.client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var trades []types.BlockTrade
+	for _, item := range rows {
+		trades = append(trades, types.BlockTrade{
+			StockCode:    item.SecurityCode,
+			ShortName:    utils.CleanString(item.SecurityNameAbbr),
+			TradeDate:    eastmoney.FormatDate(item.TradeDate),
+			Price:        item.DealPrice,
+			Close:        item.ClosePrice,
+			PremiumRatio: item.PremiumRatio,
+			Volume:       item.DealVolume,
+			Amount:       item.DealAmt,
+			BuyerBranch:  utils.CleanString(item.BuyerName),
+			SellerBranch: utils.CleanString(item.SellerName),
+			ChangePct:    item.ChangeRate,
+		})
+	}
+
+	return trades, nil
+}
@@ -0,0 +1,395 @@
+package sentiment
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/calendar"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/headers"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// changeBucketBounds 涨跌幅分布区间边界（百分比），0 单独作为平盘区间
+var changeBucketBounds = []float64{-9, -7, -5, -3, 0, 3, 5, 7, 9}
+
+const (
+	breadthHistoryWorkers = 8   // 历史涨跌分布并发拉取K线的协程数
+	quoteListMaxPages     = 100 // 全市场行情最多翻页数，每页100条
+)
+
+// 注册制新股上市后前5个交易日不设涨跌幅限制，各板块实施注册制的首批上市日期
+const (
+	chinextRegistrationDate   = "2020-08-24" // 创业板
+	mainBoardRegistrationDate = "2023-04-10" // 沪深主板
+	registrationFreeDays      = 5
+)
+
+// GetMarketBreadth 获取全市场A股当前涨跌分布快照
+func (s *Sentiment) GetMarketBreadth() (*types.MarketBreadth, error) {
+	quotes, listDates, err := s.getAllQuotesFromEast()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(quotes) == 0 {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "未找到全市场行情数据", "")
+	}
+
+	cal, err := calendar.New()
+	if err != nil {
+		return nil, err
+	}
+
+	today := utils.GetCurrentDate()
+	unlimited := make(map[string]bool)
+	for code, listDate := range listDates {
+		if isUnlimitedNewListing(cal, code, listDate, today) {
+			unlimited[code] = true
+		}
+	}
+
+	breadth := BuildMarketBreadth(today, quotes, unlimited)
+	return &breadth, nil
+}
+
+// GetMarketBreadthHistory 获取历史每日涨跌分布，同时返回拉取行情失败的股票代码
+// 由全部A股的日K线逐日汇总得到，需要逐只拉取行情，耗时较长；failed 非空时结果缺少这些股票，可重试或自行取舍
+// 股票范围为当前上市的A股（AllCode），已退市股票不在其中，越早的日期统计越偏向存续至今的公司（幸存者偏差）；
+// 股票简称取当前值，历史上ST状态变化的股票涨跌停判断可能存在偏差
+func (s *Sentiment) GetMarketBreadthHistory(startDate, endDate string) (series []types.MarketBreadth, failed []string, err error) {
+	start, end, err := normalizeDateRange(startDate, endDate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	startTime, _ := time.Parse("2006-01-02", start)
+	endTime, _ := time.Parse("2006-01-02", end)
+
+	codes, err := s.stockInfo.AllCode()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	listDates, err := s.stockInfo.GetListDates()
+	if err != nil {
+		return nil, nil, errors.WrapError(err, "获取上市日期失败")
+	}
+
+	cal, err := calendar.New()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make(map[string]string, len(codes))
+	for _, code := range codes {
+		names[code.StockCode] = code.ShortName
+	}
+
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		byDate    = make(map[string][]types.CurrentMarket)
+		unlimited = make(map[string]map[string]bool)
+		jobs      = make(chan string)
+	)
+
+	for i := 0; i < breadthHistoryWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for code := range jobs {
+				bars, err := s.stockMarket.GetMarket(&types.MarketParams{
+					StockCode: code,
+					StartDate: startTime,
+					EndDate:   endTime,
+					KType:     1,
+				})
+				if err != nil {
+					// 区间内未上市或停牌的股票没有K线，不算失败
+					if !errors.IsNoDataFound(err) {
+						mu.Lock()
+						failed = append(failed, code)
+						mu.Unlock()
+					}
+					continue
+				}
+
+				mu.Lock()
+				for _, bar := range bars {
+					byDate[bar.TradeDate] = append(byDate[bar.TradeDate], barToQuote(bar, names[code]))
+					if isUnlimitedNewListing(cal, code, listDates[code], bar.TradeDate) {
+						if unlimited[bar.TradeDate] == nil {
+							unlimited[bar.TradeDate] = make(map[string]bool)
+						}
+						unlimited[bar.TradeDate][code] = true
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for code := range names {
+		jobs <- code
+	}
+	close(jobs)
+	wg.Wait()
+
+	sort.Strings(failed)
+
+	if len(byDate) == 0 {
+		if len(failed) > 0 {
+			return nil, failed, errors.NewADataError(errors.ErrRequestFailed.Code, "获取历史行情失败", fmt.Sprintf("%d 只股票拉取失败", len(failed)))
+		}
+		return nil, nil, errors.NewADataError(errors.ErrNoDataFound.Code, "未找到历史行情数据", fmt.Sprintf("%s ~ %s", start, end))
+	}
+
+	for date, quotes := range byDate {
+		series = append(series, BuildMarketBreadth(date, quotes, unlimited[date]))
+	}
+
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].TradeDate < series[j].TradeDate
+	})
+
+	return series, failed, nil
+}
+
+// BuildMarketBreadth 根据全市场行情汇总涨跌分布，价格为0的停牌股票不参与统计
+// unlimited 为当日无涨跌幅限制的上市初期新股代码，不计入涨跌停家数，可为 nil；简称以 N 开头的上市首日新股同样不计入
+func BuildMarketBreadth(tradeDate string, quotes []types.CurrentMarket, unlimited map[string]bool) types.MarketBreadth {
+	breadth := types.MarketBreadth{
+		TradeDate:        tradeDate,
+		AmountByExchange: make(map[string]float64),
+		ChangeBuckets:    newChangeBuckets(),
+	}
+
+	for _, quote := range quotes {
+		if quote.Price <= 0 {
+			continue
+		}
+
+		breadth.Total++
+		switch {
+		case quote.ChangePct > 0:
+			breadth.Advancers++
+		case quote.ChangePct < 0:
+			breadth.Decliners++
+		default:
+			breadth.Unchanged++
+		}
+
+		if !unlimited[quote.StockCode] {
+			if isLimitUp, isLimitDown := checkLimit(quote); isLimitUp {
+				breadth.LimitUp++
+			} else if isLimitDown {
+				breadth.LimitDown++
+			}
+		}
+
+		breadth.TotalAmount += quote.Amount
+		breadth.AmountByExchange[utils.GetExchangeByStockCode(quote.StockCode)] += quote.Amount
+		breadth.ChangeBuckets[changeBucketIndex(quote.ChangePct)].Count++
+	}
+
+	return breadth
+}
+
+// checkLimit 判断是否涨停或跌停，N开头的上市首日新股无涨跌幅限制不计入
+func checkLimit(quote types.CurrentMarket) (bool, bool) {
+	if quote.PreClose <= 0 || strings.HasPrefix(quote.ShortName, "N") {
+		return false, false
+	}
+
+	ratio := utils.GetLimitRatio(quote.StockCode, utils.IsSTName(quote.ShortName))
+	up, down := utils.GetLimitPrice(quote.PreClose, ratio)
+	return quote.Price >= up, quote.Price <= down
+}
+
+// newChangeBuckets 创建涨跌幅分布区间
+func newChangeBuckets() []types.ChangeBucket {
+	var buckets []types.ChangeBucket
+	buckets = append(buckets, types.ChangeBucket{Label: fmt.Sprintf("<=%g%%", changeBucketBounds[0])})
+	for i := 1; i < len(changeBucketBounds); i++ {
+		lower, upper := changeBucketBounds[i-1], changeBucketBounds[i]
+		if upper == 0 {
+			buckets = append(buckets, types.ChangeBucket{Label: fmt.Sprintf("%g%%~0%%", lower)})
+			buckets = append(buckets, types.ChangeBucket{Label: "0%"})
+			continue
+		}
+		buckets = append(buckets, types.ChangeBucket{Label: fmt.Sprintf("%g%%~%g%%", lower, upper)})
+	}
+	buckets = append(buckets, types.ChangeBucket{Label: fmt.Sprintf(">=%g%%", changeBucketBounds[len(changeBucketBounds)-1])})
+	return buckets
+}
+
+// changeBucketIndex 计算涨跌幅所属区间下标，负区间左开右闭，正区间左闭右开
+func changeBucketIndex(changePct float64) int {
+	zero := sort.SearchFloat64s(changeBucketBounds, 0)
+
+	switch {
+	case changePct == 0:
+		return zero + 1
+	case changePct < 0:
+		for i := 0; i < zero; i++ {
+			if changePct <= changeBucketBounds[i] {
+				return i
+			}
+		}
+		return zero
+	default:
+		// 正区间位于平盘区间之后，下标需偏移
+		for i := len(changeBucketBounds) - 1; i > zero; i-- {
+			if changePct >= changeBucketBounds[i] {
+				return i + 2
+			}
+		}
+		return zero + 2
+	}
+}
+
+// isUnlimitedNewListing 判断股票在 tradeDate 是否处于上市初期无涨跌幅限制的阶段
+// 注册制新股（科创板、2020-08-24 起的创业板、2023-04-10 起的沪深主板）上市后前5个交易日不设限制，
+// 其余新股仅上市首日不设限制；上市日期未知时返回 false
+func isUnlimitedNewListing(cal *calendar.Calendar, stockCode, listDate, tradeDate string) bool {
+	if listDate == "" || tradeDate < listDate {
+		return false
+	}
+
+	days := 1
+	switch {
+	case utils.GetExchangeByStockCode(stockCode) == "BJ":
+	case strings.HasPrefix(stockCode, "68"):
+		days = registrationFreeDays
+	case strings.HasPrefix(stockCode, "30"):
+		if listDate >= chinextRegistrationDate {
+			days = registrationFreeDays
+		}
+	case listDate >= mainBoardRegistrationDate:
+		days = registrationFreeDays
+	}
+
+	if days == 1 {
+		return tradeDate == listDate
+	}
+
+	// 日历覆盖范围外按工作日推算
+	last, err := cal.NthTradingDay(listDate, days-1)
+	if err != nil {
+		t, err := time.Parse("2006-01-02", listDate)
+		if err != nil {
+			return false
+		}
+		for n := 1; n < days; {
+			t = t.AddDate(0, 0, 1)
+			if t.Weekday() != time.Saturday && t.Weekday() != time.Sunday {
+				n++
+			}
+		}
+		last = t.Format("2006-01-02")
+	}
+	return tradeDate <= last
+}
+
+// barToQuote 将日K线转换为行情快照，便于复用汇总逻辑
+func barToQuote(bar types.MarketData, shortName string) types.CurrentMarket {
+	// 涨跌额精确到分，由收盘价减涨跌额得到昨收价，避免由四舍五入后的涨跌幅反推产生误差
+	preClose := bar.PreClose
+	if preClose <= 0 {
+		preClose = math.Round((bar.Close-bar.Change)*100) / 100
+	}
+
+	return types.CurrentMarket{
+		StockCode: bar.StockCode,
+		ShortName: shortName,
+		Price:     bar.Close,
+		Change:    bar.Change,
+		ChangePct: bar.ChangePct,
+		Volume:    bar.Volume,
+		Amount:    bar.Amount,
+		PreClose:  preClose,
+	}
+}
+
+// getAllQuotesFromEast 从东方财富获取全市场A股行情快照，同时返回各股票的上市日期
+func (s *Sentiment) getAllQuotesFromEast() ([]types.CurrentMarket, map[string]string, error) {
+	baseURL := "https://82.push2.eastmoney.com/api/qt/clist/get"
+
+	var quotes []types.CurrentMarket
+	listDates := make(map[string]string)
+	pageSize := 100
+
+	for currPage := 1; ; currPage++ {
+		if currPage > quoteListMaxPages {
+			return nil, nil, errors.NewADataError(errors.ErrRequestFailed.Code, "数据页数超过上限",
+				fmt.Sprintf("东方财富全市场行情: 超过%d页", quoteListMaxPages))
+		}
+
+		params := map[string]string{
+			"pn":     strconv.Itoa(currPage),
+			"pz":     strconv.Itoa(pageSize),
+			"po":     "1",
+			"np":     "1",
+			"ut":     "bd1d9ddb04089700cf9c27f6f7426281",
+			"fltt":   "2",
+			"invt":   "2",
+			"fid":    "f3",
+			"fs":     "m:0 t:6,m:0 t:80,m:1 t:2,m:1 t:23,m:0 t:81 s:2048",
+			"fields": "f2,f3,f4,f6,f12,f14,f18,f26",
+			"_":      strconv.FormatInt(time.Now().UnixMilli(), 10),
+		}
+
+		// 停牌股票的数值字段返回 "-"，因此按通用类型解析
+		var result struct {
+			Data struct {
+				Diff []struct {
+					F2  interface{} `json:"f2"`  // 最新价
+					F3  interface{} `json:"f3"`  // 涨跌幅
+					F4  interface{} `json:"f4"`  // 涨跌额
+					F6  interface{} `json:"f6"`  // 成交额
+					F12 string      `json:"f12"` // 股票代码
+					F14 string      `json:"f14"` // 股票简称
+					F18 interface{} `json:"f18"` // 昨收价
+					F26 interface{} `json:"f26"` // 上市日期，如 19910403
+				} `json:"diff"`
+			} `json:"data"`
+		}
+
+		err := s.client.GetJSON(baseURL, params, headers.EastMoneyHeaders, &result)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, item := range result.Data.Diff {
+			if listDate := int64(utils.ToFloat(item.F26)); listDate > 0 {
+				if formatted, err := utils.FormatDate(strconv.FormatInt(listDate, 10)); err == nil {
+					listDates[item.F12] = formatted
+				}
+			}
+			quotes = append(quotes, types.CurrentMarket{
+				StockCode: item.F12,
+				ShortName: utils.CleanString(item.F14),
+				Price:     utils.ToFloat(item.F2),
+				ChangePct: utils.ToFloat(item.F3),
+				Change:    utils.ToFloat(item.F4),
+				Amount:    utils.ToFloat(item.F6),
+				PreClose:  utils.ToFloat(item.F18),
+			})
+		}
+
+		if len(result.Data.Diff) < pageSize {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return quotes, listDates, nil
+}
@@ -0,0 +1,238 @@
+package sentiment
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/onepiecelover/adata-go/pkg/common/eastmoney"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// institutionBranchName 机构专用席位名称
+const institutionBranchName = "机构专用"
+
+// branchCodeRe 营业部代码，东方财富使用纯数字编码
+var branchCodeRe = regexp.MustCompile(`^\d+$`)
+
+// GetDragonTiger 获取龙虎榜每日上榜数据，日期格式如 2024-01-10，为空时默认当天
+func (s *Sentiment) GetDragonTiger(startDate, endDate string) ([]types.DragonTiger, error) {
+	start, end, err := normalizeDateRange(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	query := eastmoney.Query{
+		ReportName:  "RPT_DAILYBILLBOARD_DETAILSNEW",
+		Columns:     "SECURITY_CODE,SECURITY_NAME_ABBR,TRADE_DATE,EXPLANATION,CLOSE_PRICE,CHANGE_RATE,BILLBOARD_NET_AMT,BILLBOARD_BUY_AMT,BILLBOARD_SELL_AMT,BILLBOARD_DEAL_AMT,ACCUM_AMOUNT,DEAL_NET_RATIO,DEAL_AMOUNT_RATIO,TURNOVERRATE,FREE_MARKET_CAP",
+		Filter:      fmt.Sprintf("(TRADE_DATE>='%s')(TRADE_DATE<='%s')", start, end),
+		SortColumns: "TRADE_DATE,SECURITY_CODE",
+		SortTypes:   "-1,1",
+	}
+
+	rows, err := eastmoney.FetchAll[struct {
+		SecurityCode     string  `json:"SECURITY_CODE"`
+		SecurityNameAbbr string  `json:"SECURITY_NAME_ABBR"`
+		TradeDate        string  `json:"TRADE_DATE"`
+		Explanation      string  `json:"EXPLANATION"`
+		ClosePrice       float64 `json:"CLOSE_PRICE"`
+		ChangeRate       float64 `json:"CHANGE_RATE"`
+		BillboardNetAmt  float64 `json:"BILLBOARD_NET_AMT"`
+		BillboardBuyAmt  float64 `json:"BILLBOARD_BUY_AMT"`
+		BillboardSellAmt float64 `json:"BILLBOARD_SELL_AMT"`
+		BillboardDealAmt float64 `json:"BILLBOARD_DEAL_AMT"`
+		AccumAmount      float64 `json:"ACCUM_AMOUNT"`
+		DealNetRatio     float64 `json:"DEAL_NET_RATIO"`
+		DealAmountRatio  float64 `json:"DEAL_AMOUNT_RATIO"`
+		TurnoverRate     float64 `json:"TURNOVERRATE"`
+		FreeMarketCap    float64 `json:"FREE_MARKET_CAP"`
+	}](s.client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []types.DragonTiger
+	for _, item := range rows {
+		list = append(list, types.DragonTiger{
+			StockCode:   item.SecurityCode,
+			ShortName:   utils.CleanString(item.SecurityNameAbbr),
+			TradeDate:   eastmoney.FormatDate(item.TradeDate),
+			Reason:      item.Explanation,
+			Close:       item.ClosePrice,
+			ChangePct:   item.ChangeRate,
+			NetBuy:      item.BillboardNetAmt,
+			BuyAmount:   item.BillboardBuyAmt,
+			SellAmount:  item.BillboardSellAmt,
+			DealAmount:  item.BillboardDealAmt,
+			TotalAmount: item.AccumAmount,
+			NetBuyRatio: item.DealNetRatio,
+			DealRatio:   item.DealAmountRatio,
+			Turnover:    item.TurnoverRate,
+			CircMarket:  item.FreeMarketCap,
+		})
+	}
+
+	return list, nil
+}
+
+// GetDragonTigerDetail 获取个股某日龙虎榜买入、卖出前五营业部明细
+func (s *Sentiment) GetDragonTigerDetail(stockCode, tradeDate string) ([]types.DragonTigerSeat, error) {
+	if !utils.IsValidStockCode(stockCode) {
+		return nil, errors.ErrInvalidStockCode
+	}
+
+	date, err := utils.FormatDate(tradeDate)
+	if err != nil || date == "" {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	var seats []types.DragonTigerSeat
+	sides := []struct {
+		side       string
+		reportName string
+		sortColumn string
+	}{
+		{"buy", "RPT_BILLBOARD_DAILYDETAILSBUY", "BUY"},
+		{"sell", "RPT_BILLBOARD_DAILYDETAILSSELL", "SELL"},
+	}
+
+	for _, side := range sides {
+		query := eastmoney.Query{
+			ReportName:  side.reportName,
+			Filter:      fmt.Sprintf(`(TRADE_DATE='%s')(SECURITY_CODE="%s")`, date, stockCode),
+			SortColumns: side.sortColumn,
+			SortTypes:   "-1",
+		}
+
+		rows, err := eastmoney.FetchAll[struct {
+			OperateDeptCode string  `json:"OPERATEDEPT_CODE"`
+			OperateDeptName string  `json:"OPERATEDEPT_NAME"`
+			Buy             float64 `json:"BUY"`
+			Sell            float64 `json:"SELL"`
+			Net             float64 `json:"NET"`
+			TotalBuyRio     float64 `json:"TOTAL_BUYRIO"`
+			TotalSellRio    float64 `json:"TOTAL_SELLRIO"`
+			Explanation     string  `json:"EXPLANATION"`
+		}](s.client, query)
+		if err != nil {
+			return nil, err
+		}
+
+		// 同一股票同日可能因多个原因上榜，排名按上榜原因分别计算
+		ranks := make(map[string]int)
+		for _, item := range rows {
+			ranks[item.Explanation]++
+			seats = append(seats, types.DragonTigerSeat{
+				StockCode:     stockCode,
+				TradeDate:     date,
+				Side:          side.side,
+				Rank:          ranks[item.Explanation],
+				BranchCode:    item.OperateDeptCode,
+				BranchName:    utils.CleanString(item.OperateDeptName),
+				BuyAmount:     item.Buy,
+				SellAmount:    item.Sell,
+				NetAmount:     item.Net,
+				BuyRatio:      item.TotalBuyRio,
+				SellRatio:     item.TotalSellRio,
+				IsInstitution: item.OperateDeptName == institutionBranchName,
+				Reason:        item.Explanation,
+			})
+		}
+	}
+
+	return seats, nil
+}
+
+// GetBranchHistory 获取营业部历史上榜交易，用于跟踪游资席位
+func (s *Sentiment) GetBranchHistory(branchCode, startDate, endDate string) ([]types.DragonTigerBranchTrade, error) {
+	if branchCode == "" {
+		return nil, errors.NewADataError(errors.ErrInvalidStockCode.Code, "营业部代码不能为空", "")
+	}
+	if !branchCodeRe.MatchString(branchCode) {
+		return nil, errors.NewADataError(errors.ErrInvalidStockCode.Code, "营业部代码应为数字", branchCode)
+	}
+
+	filter := fmt.Sprintf(`(OPERATEDEPT_CODE="%s")`, branchCode)
+	if startDate != "" {
+		start, err := utils.FormatDate(startDate)
+		if err != nil {
+			return nil, errors.ErrInvalidDateFormat
+		}
+		filter += fmt.Sprintf("(TRADE_DATE>='%s')", start)
+	}
+	if endDate != "" {
+		end, err := utils.FormatDate(endDate)
+		if err != nil {
+			return nil, errors.ErrInvalidDateFormat
+		}
+		filter += fmt.Sprintf("(TRADE_DATE<='%s')", end)
+	}
+
+	query := eastmoney.Query{
+		ReportName:  "RPT_OPERATEDEPT_TRADE_DETAILSNEW",
+		Filter:      filter,
+		SortColumns: "TRADE_DATE,SECURITY_CODE",
+		SortTypes:   "-1,1",
+	}
+
+	rows, err := eastmoney.FetchAll[struct {
+		OperateDeptCode  string  `json:"OPERATEDEPT_CODE"`
+		OperateDeptName  string  `json:"OPERATEDEPT_NAME"`
+		TradeDate        string  `json:"TRADE_DATE"`
+		SecurityCode     string  `json:"SECURITY_CODE"`
+		SecurityNameAbbr string  `json:"SECURITY_NAME_ABBR"`
+		ActBuy           float64 `json:"ACT_BUY"`
+		ActSell          float64 `json:"ACT_SELL"`
+		NetAmt           float64 `json:"NET_AMT"`
+		ChangeRate       float64 `json:"CHANGE_RATE"`
+		Explanation      string  `json:"EXPLANATION"`
+	}](s.client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var trades []types.DragonTigerBranchTrade
+	for _, item := range rows {
+		trades = append(trades, types.DragonTigerBranchTrade{
+			BranchCode: item.OperateDeptCode,
+			BranchName: utils.CleanString(item.OperateDeptName),
+			TradeDate:  eastmoney.FormatDate(item.TradeDate),
+			StockCode:  item.SecurityCode,
+			ShortName:  utils.CleanString(item.SecurityNameAbbr),
+			BuyAmount:  item.ActBuy,
+			SellAmount: item.ActSell,
+			NetAmount:  item.NetAmt,
+			ChangePct:  item.ChangeRate,
+			Reason:     item.Explanation,
+		})
+	}
+
+	return trades, nil
+}
+
+// normalizeDateRange 规范化日期区间，为空时默认当天
+func normalizeDateRange(startDate, endDate string) (string, string, error) {
+	start, err := utils.FormatDate(startDate)
+	if err != nil {
+		return "", "", errors.ErrInvalidDateFormat
+	}
+
+	end, err := utils.FormatDate(endDate)
+	if err != nil {
+		return "", "", errors.ErrInvalidDateFormat
+	}
+
+	if end == "" {
+		end = utils.GetCurrentDate()
+	}
+	if start == "" {
+		start = end
+	}
+
+	if start > end {
+		return "", "", errors.NewADataError(errors.ErrInvalidDateFormat.Code, "开始日期不能晚于结束日期", fmt.Sprintf("%s > %s", start, end))
+	}
+
+	return start, end, nil
+}
@@ -0,0 +1,196 @@
+package sentiment
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/headers"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// 股池类型
+const (
+	PoolTypeUp     = "up"     // 涨停股池
+	PoolTypeDown   = "down"   // 跌停股池
+	PoolTypeBroken = "broken" // 炸板股池
+)
+
+// limitPoolAPIs 各股池对应的东方财富接口
+var limitPoolAPIs = map[string]struct {
+	path string
+	sort string
+}{
+	PoolTypeUp:     {"getTopicZTPool", "fbt:asc"},
+	PoolTypeDown:   {"getTopicDTPool", "fund:asc"},
+	PoolTypeBroken: {"getTopicZBPool", "fbt:asc"},
+}
+
+// GetLimitUpPool 获取涨停股池，日期格式如 2024-01-10，为空时默认当天
+func (s *Sentiment) GetLimitUpPool(date string) ([]types.LimitPool, error) {
+	return s.getLimitPool(PoolTypeUp, date)
+}
+
+// GetLimitDownPool 获取跌停股池
+func (s *Sentiment) GetLimitDownPool(date string) ([]types.LimitPool, error) {
+	return s.getLimitPool(PoolTypeDown, date)
+}
+
+// GetBrokenLimitPool 获取炸板股池（曾涨停但收盘未封住）
+func (s *Sentiment) GetBrokenLimitPool(date string) ([]types.LimitPool, error) {
+	return s.getLimitPool(PoolTypeBroken, date)
+}
+
+// GetLimitStreakStats 获取连板高度统计，按连板数从高到低排列
+func (s *Sentiment) GetLimitStreakStats(date string) ([]types.LimitStreakStat, error) {
+	pool, err := s.GetLimitUpPool(date)
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildLimitStreakStats(pool), nil
+}
+
+// BuildLimitStreakStats 根据涨停股池统计各连板高度的股票数量
+func BuildLimitStreakStats(pool []types.LimitPool) []types.LimitStreakStat {
+	statMap := make(map[int]*types.LimitStreakStat)
+	for _, item := range pool {
+		streak := item.Streak
+		if streak < 1 {
+			streak = 1
+		}
+
+		stat, exists := statMap[streak]
+		if !exists {
+			stat = &types.LimitStreakStat{
+				TradeDate: item.TradeDate,
+				Streak:    streak,
+			}
+			statMap[streak] = stat
+		}
+		stat.Count++
+		stat.StockCodes = append(stat.StockCodes, item.StockCode)
+	}
+
+	var stats []types.LimitStreakStat
+	for _, stat := range statMap {
+		stats = append(stats, *stat)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Streak > stats[j].Streak
+	})
+
+	return stats
+}
+
+// getLimitPool 从东方财富获取指定类型的股池
+func (s *Sentiment) getLimitPool(poolType, date string) ([]types.LimitPool, error) {
+	apiDate, err := utils.FormatDateForAPI(date)
+	if err != nil {
+		return nil, errors.ErrInvalidDateFormat
+	}
+	if apiDate == "" {
+		apiDate = utils.GetCurrentDateForAPI()
+	}
+
+	api := limitPoolAPIs[poolType]
+	baseURL := "https://push2ex.eastmoney.com/" + api.path
+	params := map[string]string{
+		"ut":        "7eea3edcaed734bea9cbfc24409ed989",
+		"dpt":       "wz.ztzt",
+		"Pageindex": "0",
+		"pagesize":  "10000",
+		"sort":      api.sort,
+		"date":      apiDate,
+		"_":         strconv.FormatInt(time.Now().UnixMilli(), 10),
+	}
+
+	var result struct {
+		RC   int `json:"rc"`
+		Data *struct {
+			Pool []struct {
+				C      string  `json:"c"`      // 股票代码
+				N      string  `json:"n"`      // 股票简称
+				P      float64 `json:"p"`      // 最新价，单位厘
+				Ztp    float64 `json:"ztp"`    // 涨停价，单位厘
+				Zdp    float64 `json:"zdp"`    // 涨跌幅
+				Amount float64 `json:"amount"` // 成交额
+				Ltsz   float64 `json:"ltsz"`   // 流通市值
+				Hs     float64 `json:"hs"`     // 换手率
+				Lbc    int     `json:"lbc"`    // 连板数
+				Days   int     `json:"days"`   // 连续跌停天数
+				Fbt    int     `json:"fbt"`    // 首次封板时间
+				Lbt    int     `json:"lbt"`    // 最后封板时间
+				Fund   float64 `json:"fund"`   // 涨停封板资金
+				Fba    float64 `json:"fba"`    // 跌停封单资金
+				Zbc    int     `json:"zbc"`    // 炸板次数
+				Oc     int     `json:"oc"`     // 跌停开板次数
+				Hybk   string  `json:"hybk"`   // 所属行业
+			} `json:"pool"`
+		} `json:"data"`
+	}
+
+	err = s.client.GetJSON(baseURL, params, headers.EastMoneyHeaders, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Data == nil {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "未找到股池数据", apiDate)
+	}
+
+	tradeDate, _ := utils.FormatDate(apiDate)
+
+	var pool []types.LimitPool
+	for _, item := range result.Data.Pool {
+		price := item.P / 1000
+		ratio := utils.GetLimitRatio(item.C, utils.IsSTName(item.N))
+
+		entry := types.LimitPool{
+			StockCode:      item.C,
+			ShortName:      utils.CleanString(item.N),
+			TradeDate:      tradeDate,
+			PoolType:       poolType,
+			Price:          price,
+			ChangePct:      item.Zdp,
+			LimitPrice:     price,
+			LimitRatio:     ratio,
+			Amount:         item.Amount,
+			CircMarket:     item.Ltsz,
+			Turnover:       item.Hs,
+			FirstLimitTime: formatPoolTime(item.Fbt),
+			LastLimitTime:  formatPoolTime(item.Lbt),
+			Industry:       item.Hybk,
+		}
+
+		switch poolType {
+		case PoolTypeUp:
+			entry.SealAmount = item.Fund
+			entry.OpenTimes = item.Zbc
+			entry.Streak = item.Lbc
+		case PoolTypeDown:
+			entry.SealAmount = item.Fba
+			entry.OpenTimes = item.Oc
+			entry.Streak = item.Days
+		case PoolTypeBroken:
+			entry.OpenTimes = item.Zbc
+			entry.LimitPrice = item.Ztp / 1000
+		}
+
+		pool = append(pool, entry)
+	}
+
+	return pool, nil
+}
+
+// formatPoolTime 将东方财富股池的整数时间（如 93000）格式化为 09:30:00
+func formatPoolTime(t int) string {
+	if t <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%02d:%02d:%02d", t/10000, t/100%100, t%100)
+}
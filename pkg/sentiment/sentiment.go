@@ -4,17 +4,23 @@ package sentiment
 import (
 	"github.com/onepiecelover/adata-go/pkg/common/client"
 	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/stock/info"
+	"github.com/onepiecelover/adata-go/pkg/stock/market"
 )
 
 // Sentiment 情感指标模块结构体
 type Sentiment struct {
-	client *client.Client
+	client      *client.Client
+	stockInfo   *info.StockInfo
+	stockMarket *market.StockMarket
 }
 
 // New 创建情感指标模块实例
 func New() *Sentiment {
 	return &Sentiment{
-		client: client.NewClient(),
+		client:      client.NewClient(),
+		stockInfo:   info.NewStockInfo(),
+		stockMarket: market.NewStockMarket(),
 	}
 }
 
@@ -27,6 +33,8 @@ func SetProxy(enabled bool, proxyURL string) {
 // SetProxy 设置代理
 func (s *Sentiment) SetProxy(enabled bool, proxyURL string) {
 	s.client.SetProxy(enabled, proxyURL)
+	s.stockInfo.SetProxy(enabled, proxyURL)
+	s.stockMarket.SetProxy(enabled, proxyURL)
 }
 
 // GetHotList 获取热门板块
@@ -0,0 +1,187 @@
+// Package adjust 提供基于不复权K线和公司行为的本地复权计算
+package adjust
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// 复权类型，与 types.MarketParams.AdjustType 取值一致
+const (
+	None     = 0 // 不复权
+	Forward  = 1 // 前复权
+	Backward = 2 // 后复权
+)
+
+// Factors 根据不复权K线和公司行为计算每个交易日的累计复权因子
+// 除权除息日的参考价 = (前收盘价 - 每股派息 + 配股价 × 每股配股数) / (1 + 每股送转股数 + 每股配股数)，
+// 当日因子 = 前收盘价 / 参考价；除权日停牌时在复牌首日生效，首根K线之前的公司行为不参与计算
+func Factors(bars []types.MarketData, actions []types.CorporateAction) ([]types.AdjustFactor, error) {
+	sorted := sortBars(bars)
+
+	events := make([]types.CorporateAction, len(actions))
+	copy(events, actions)
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ExDate < events[j].ExDate
+	})
+
+	factors := make([]types.AdjustFactor, 0, len(sorted))
+	factor := 1.0
+	next := 0
+
+	for i, bar := range sorted {
+		if i == 0 {
+			// 跳过首根K线及之前的公司行为
+			for next < len(events) && events[next].ExDate <= bar.TradeDate {
+				next++
+			}
+		} else {
+			preClose := sorted[i-1].Close
+			for next < len(events) && events[next].ExDate <= bar.TradeDate {
+				ratio, err := exRightsRatio(preClose, events[next])
+				if err != nil {
+					return nil, err
+				}
+				factor *= ratio
+				// 同一区间内多次除权时，以参考价作为下一次的前收盘价
+				preClose /= ratio
+				next++
+			}
+		}
+
+		factors = append(factors, types.AdjustFactor{
+			StockCode: bar.StockCode,
+			TradeDate: bar.TradeDate,
+			Factor:    factor,
+		})
+	}
+
+	return factors, nil
+}
+
+// Adjust 使用复权因子计算前复权或后复权K线，成交量和成交额保持不变
+// 前复权以最后一根K线为基准，后复权以首根K线为基准
+func Adjust(bars []types.MarketData, factors []types.AdjustFactor, adjustType int) ([]types.MarketData, error) {
+	sorted := sortBars(bars)
+	if len(sorted) == 0 {
+		return sorted, nil
+	}
+
+	switch adjustType {
+	case None:
+		return sorted, nil
+	case Backward:
+		return scale(sorted, factors, 1)
+	case Forward:
+		lookup := factorMap(factors)
+		base, ok := lookup[sorted[len(sorted)-1].TradeDate]
+		if !ok {
+			return nil, missingFactor(sorted[len(sorted)-1].TradeDate)
+		}
+		return scale(sorted, factors, base)
+	default:
+		return nil, errors.NewADataError(errors.ErrInvalidParameter.Code, "不支持的复权类型", fmt.Sprint(adjustType))
+	}
+}
+
+// AdjustAt 以指定日期为基准复权，基准日及之后至下一次除权前的价格与不复权价格一致
+// 基准日非交易日时取此前最近一个交易日的因子，早于全部K线时以首根K线为基准
+func AdjustAt(bars []types.MarketData, factors []types.AdjustFactor, anchorDate string) ([]types.MarketData, error) {
+	sorted := sortBars(bars)
+	if len(sorted) == 0 {
+		return sorted, nil
+	}
+
+	sortedFactors := make([]types.AdjustFactor, len(factors))
+	copy(sortedFactors, factors)
+	sort.Slice(sortedFactors, func(i, j int) bool {
+		return sortedFactors[i].TradeDate < sortedFactors[j].TradeDate
+	})
+
+	if len(sortedFactors) == 0 {
+		return nil, missingFactor(sorted[0].TradeDate)
+	}
+
+	base := sortedFactors[0].Factor
+	for _, f := range sortedFactors {
+		if f.TradeDate > anchorDate {
+			break
+		}
+		base = f.Factor
+	}
+
+	return scale(sorted, factors, base)
+}
+
+// Apply 根据不复权K线和公司行为直接计算复权K线
+func Apply(bars []types.MarketData, actions []types.CorporateAction, adjustType int) ([]types.MarketData, error) {
+	factors, err := Factors(bars, actions)
+	if err != nil {
+		return nil, err
+	}
+	return Adjust(bars, factors, adjustType)
+}
+
+// exRightsRatio 计算单次除权除息的复权比例：前收盘价 / 除权参考价
+func exRightsRatio(preClose float64, action types.CorporateAction) (float64, error) {
+	refPrice := (preClose - action.CashPerShare + action.RightsPrice*action.RightsRatio) /
+		(1 + action.BonusPerShare + action.RightsRatio)
+
+	if preClose <= 0 || refPrice <= 0 {
+		return 0, errors.NewADataError(errors.ErrParseResponseFailed.Code, "除权参考价无效",
+			fmt.Sprintf("%s %s 前收盘价 %.4f", action.StockCode, action.ExDate, preClose))
+	}
+
+	return preClose / refPrice, nil
+}
+
+// scale 将价格字段乘以 因子 / base
+func scale(bars []types.MarketData, factors []types.AdjustFactor, base float64) ([]types.MarketData, error) {
+	lookup := factorMap(factors)
+
+	adjusted := make([]types.MarketData, len(bars))
+	for i, bar := range bars {
+		factor, ok := lookup[bar.TradeDate]
+		if !ok {
+			return nil, missingFactor(bar.TradeDate)
+		}
+
+		ratio := factor / base
+		bar.Open *= ratio
+		bar.High *= ratio
+		bar.Low *= ratio
+		bar.Close *= ratio
+		bar.PreClose *= ratio
+		bar.Change *= ratio
+		adjusted[i] = bar
+	}
+
+	return adjusted, nil
+}
+
+// sortBars 复制并按交易日期升序排列K线
+func sortBars(bars []types.MarketData) []types.MarketData {
+	sorted := make([]types.MarketData, len(bars))
+	copy(sorted, bars)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].TradeDate < sorted[j].TradeDate
+	})
+	return sorted
+}
+
+// factorMap 按交易日期索引复权因子
+func factorMap(factors []types.AdjustFactor) map[string]float64 {
+	lookup := make(map[string]float64, len(factors))
+	for _, f := range factors {
+		lookup[f.TradeDate] = f.Factor
+	}
+	return lookup
+}
+
+// missingFactor 构造缺少复权因子的错误
+func missingFactor(tradeDate string) error {
+	return errors.NewADataError(errors.ErrNoDataFound.Code, "缺少复权因子", tradeDate)
+}
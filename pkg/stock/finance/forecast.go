@@ -0,0 +1,168 @@
+package finance
+
+import (
+	"fmt"
+
+	"github.com/onepiecelover/adata-go/pkg/common/eastmoney"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// forecastColumns 业绩预告返回列
+const forecastColumns = "SECURITY_CODE,SECURITY_NAME_ABBR,REPORT_DATE,NOTICE_DATE,PREDICT_FINANCE,PREDICT_TYPE,PREDICT_AMT_LOWER,PREDICT_AMT_UPPER,ADD_AMP_LOWER,ADD_AMP_UPPER,PREYEAR_SAME_PERIOD,PREDICT_CONTENT,CHANGE_REASON_EXPLAIN"
+
+// expressColumns 业绩快报返回列
+const expressColumns = "SECURITY_CODE,SECURITY_NAME_ABBR,REPORT_DATE,UPDATE_DATE,TOTAL_OPERATE_INCOME,TOTAL_OPERATE_INCOME_SQ,YSTZ,PARENT_NETPROFIT,PARENT_NETPROFIT_SQ,JLRTBZCL,BASIC_EPS,PARENT_BVPS,WEIGHTAVG_ROE"
+
+// GetEarningsForecast 获取单只股票历次业绩预告，按公告日期降序排列
+// 业绩预告仅在满足披露条件时发布，从未预告的股票返回空结果而不是错误
+func (s *StockFinance) GetEarningsForecast(stockCode string) ([]types.EarningsForecast, error) {
+	if !utils.IsValidStockCode(stockCode) {
+		return nil, errors.ErrInvalidStockCode
+	}
+
+	return s.getEarningsForecast(fmt.Sprintf(`(SECURITY_CODE="%s")`, stockCode))
+}
+
+// GetEarningsForecastByPeriod 获取全市场指定报告期的业绩预告，如 2024-12-31，按公告日期降序排列
+// 尚无公司预告的报告期返回空结果
+func (s *StockFinance) GetEarningsForecastByPeriod(reportDate string) ([]types.EarningsForecast, error) {
+	date, err := utils.FormatDate(reportDate)
+	if err != nil || date == "" {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	return s.getEarningsForecast(fmt.Sprintf("(REPORT_DATE='%s')", date))
+}
+
+// GetEarningsExpress 获取单只股票历次业绩快报，按公告日期降序排列
+// 业绩快报为自愿披露，从未发布快报的股票返回空结果而不是错误
+func (s *StockFinance) GetEarningsExpress(stockCode string) ([]types.EarningsExpress, error) {
+	if !utils.IsValidStockCode(stockCode) {
+		return nil, errors.ErrInvalidStockCode
+	}
+
+	return s.getEarningsExpress(fmt.Sprintf(`(SECURITY_CODE="%s")`, stockCode))
+}
+
+// GetEarningsExpressByPeriod 获取全市场指定报告期的业绩快报，如 2024-12-31，按公告日期降序排列
+// 尚无公司发布快报的报告期返回空结果
+func (s *StockFinance) GetEarningsExpressByPeriod(reportDate string) ([]types.EarningsExpress, error) {
+	date, err := utils.FormatDate(reportDate)
+	if err != nil || date == "" {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	return s.getEarningsExpress(fmt.Sprintf("(REPORT_DATE='%s')", date))
+}
+
+// getEarningsForecast 从东方财富获取业绩预告，同一公告按预测指标拆分为多条
+func (s *StockFinance) getEarningsForecast(filter string) ([]types.EarningsForecast, error) {
+	query := eastmoney.Query{
+		URL:         s.datacenterURL,
+		ReportName:  "RPT_PUBLIC_OP_NEWPREDICT",
+		Columns:     forecastColumns,
+		Filter:      filter,
+		SortColumns: "NOTICE_DATE,SECURITY_CODE",
+		SortTypes:   "-1,1",
+	}
+
+	rows, err := eastmoney.FetchAll[struct {
+		SecurityCode        string  `json:"SECURITY_CODE"`
+		SecurityNameAbbr    string  `json:"SECURITY_NAME_ABBR"`
+		ReportDate          string  `json:"REPORT_DATE"`
+		NoticeDate          string  `json:"NOTICE_DATE"`
+		PredictFinance      string  `json:"PREDICT_FINANCE"`
+		PredictType         string  `json:"PREDICT_TYPE"`
+		PredictAmtLower     float64 `json:"PREDICT_AMT_LOWER"`
+		PredictAmtUpper     float64 `json:"PREDICT_AMT_UPPER"`
+		AddAmpLower         float64 `json:"ADD_AMP_LOWER"`
+		AddAmpUpper         float64 `json:"ADD_AMP_UPPER"`
+		PreyearSamePeriod   float64 `json:"PREYEAR_SAME_PERIOD"`
+		PredictContent      string  `json:"PREDICT_CONTENT"`
+		ChangeReasonExplain string  `json:"CHANGE_REASON_EXPLAIN"`
+	}](s.client, query)
+	if err != nil {
+		if errors.IsNoDataFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var forecasts []types.EarningsForecast
+	for _, item := range rows {
+		forecasts = append(forecasts, types.EarningsForecast{
+			StockCode:     item.SecurityCode,
+			ShortName:     utils.CleanString(item.SecurityNameAbbr),
+			ReportDate:    eastmoney.FormatDate(item.ReportDate),
+			NoticeDate:    eastmoney.FormatDate(item.NoticeDate),
+			Indicator:     item.PredictFinance,
+			ForecastType:  item.PredictType,
+			ValueLower:    item.PredictAmtLower,
+			ValueUpper:    item.PredictAmtUpper,
+			ChangeLower:   item.AddAmpLower,
+			ChangeUpper:   item.AddAmpUpper,
+			PreviousValue: item.PreyearSamePeriod,
+			Content:       utils.CleanString(item.PredictContent),
+			ChangeReason:  utils.CleanString(item.ChangeReasonExplain),
+		})
+	}
+
+	return forecasts, nil
+}
+
+// getEarningsExpress 从东方财富获取业绩快报
+func (s *StockFinance) getEarningsExpress(filter string) ([]types.EarningsExpress, error) {
+	query := eastmoney.Query{
+		URL:         s.datacenterURL,
+		ReportName:  "RPT_FCI_PERFORMANCEE",
+		Columns:     expressColumns,
+		Filter:      filter,
+		SortColumns: "UPDATE_DATE,SECURITY_CODE",
+		SortTypes:   "-1,1",
+	}
+
+	rows, err := eastmoney.FetchAll[struct {
+		SecurityCode         string  `json:"SECURITY_CODE"`
+		SecurityNameAbbr     string  `json:"SECURITY_NAME_ABBR"`
+		ReportDate           string  `json:"REPORT_DATE"`
+		UpdateDate           string  `json:"UPDATE_DATE"`
+		TotalOperateIncome   float64 `json:"TOTAL_OPERATE_INCOME"`
+		TotalOperateIncomeSQ float64 `json:"TOTAL_OPERATE_INCOME_SQ"`
+		YSTZ                 float64 `json:"YSTZ"`
+		ParentNetprofit      float64 `json:"PARENT_NETPROFIT"`
+		ParentNetprofitSQ    float64 `json:"PARENT_NETPROFIT_SQ"`
+		JLRTBZCL             float64 `json:"JLRTBZCL"`
+		BasicEPS             float64 `json:"BASIC_EPS"`
+		ParentBVPS           float64 `json:"PARENT_BVPS"`
+		WeightavgROE         float64 `json:"WEIGHTAVG_ROE"`
+	}](s.client, query)
+	if err != nil {
+		if errors.IsNoDataFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var expresses []types.EarningsExpress
+	for _, item := range rows {
+		expresses = append(expresses, types.EarningsExpress{
+			StockCode:         item.SecurityCode,
+			ShortName:         utils.CleanString(item.SecurityNameAbbr),
+			ReportDate:        eastmoney.FormatDate(item.ReportDate),
+			NoticeDate:        eastmoney.FormatDate(item.UpdateDate),
+			Revenue:           item.TotalOperateIncome,
+			RevenueLastYear:   item.TotalOperateIncomeSQ,
+			RevenueYoY:        item.YSTZ,
+			NetProfit:         item.ParentNetprofit,
+			NetProfitLastYear: item.ParentNetprofitSQ,
+			NetProfitYoY:      item.JLRTBZCL,
+			EPS:               item.BasicEPS,
+			BPS:               item.ParentBVPS,
+			ROE:               item.WeightavgROE,
+		})
+	}
+
+	return expresses, nil
+}
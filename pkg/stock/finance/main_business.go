@@ -0,0 +1,110 @@
+package finance
+
+import (
+	"fmt"
+
+	"github.com/onepiecelover/adata-go/pkg/common/eastmoney"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/symbol"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// 主营构成分类方式
+const (
+	MainBusinessByIndustry = "按行业"
+	MainBusinessByProduct  = "按产品"
+	MainBusinessByRegion   = "按地区"
+)
+
+// mainBusinessCategories 东方财富 MAINOP_TYPE 与分类方式的对应关系
+var mainBusinessCategories = map[string]string{
+	"1": MainBusinessByIndustry,
+	"2": MainBusinessByProduct,
+	"3": MainBusinessByRegion,
+}
+
+// GetMainBusiness 获取主营构成，按行业、产品、地区拆分收入、成本、利润及毛利率
+// reportDate 为空时返回全部报告期，结果按报告期降序、分类和排序升序排列
+func (s *StockFinance) GetMainBusiness(stockCode, reportDate string) ([]types.MainBusiness, error) {
+	if !utils.IsValidStockCode(stockCode) {
+		return nil, errors.ErrInvalidStockCode
+	}
+
+	date, err := utils.FormatDate(reportDate)
+	if err != nil {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	sym, err := symbol.Parse(stockCode)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := fmt.Sprintf(`(SECUCODE="%s")`, sym.String())
+	if date != "" {
+		filter += fmt.Sprintf("(REPORT_DATE='%s')", date)
+	}
+
+	query := eastmoney.Query{
+		URL:         s.securitiesEndpoint(),
+		Source:      "HSF10",
+		Client:      "PC",
+		ReportName:  "RPT_F10_FN_MAINOP",
+		Columns:     "SECUCODE,SECURITY_CODE,REPORT_DATE,MAINOP_TYPE,ITEM_NAME,MAIN_BUSINESS_INCOME,MBI_RATIO,MAIN_BUSINESS_COST,MBC_RATIO,MAIN_BUSINESS_RPOFIT,MBR_RATIO,GROSS_RPOFIT_RATIO,RANK",
+		Filter:      filter,
+		SortColumns: "REPORT_DATE,MAINOP_TYPE,RANK",
+		SortTypes:   "-1,1,1",
+	}
+
+	rows, err := eastmoney.FetchAll[mainBusinessRow](s.client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]types.MainBusiness, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, row.toMainBusiness())
+	}
+
+	return items, nil
+}
+
+// mainBusinessRow 东方财富主营构成原始字段，RPOFIT 为数据源原始拼写
+type mainBusinessRow struct {
+	SecurityCode       string  `json:"SECURITY_CODE"`
+	ReportDate         string  `json:"REPORT_DATE"`
+	MainopType         string  `json:"MAINOP_TYPE"`
+	ItemName           string  `json:"ITEM_NAME"`
+	MainBusinessIncome float64 `json:"MAIN_BUSINESS_INCOME"`
+	MBIRatio           float64 `json:"MBI_RATIO"`
+	MainBusinessCost   float64 `json:"MAIN_BUSINESS_COST"`
+	MBCRatio           float64 `json:"MBC_RATIO"`
+	MainBusinessProfit float64 `json:"MAIN_BUSINESS_RPOFIT"`
+	MBRRatio           float64 `json:"MBR_RATIO"`
+	GrossProfitRatio   float64 `json:"GROSS_RPOFIT_RATIO"`
+	Rank               int     `json:"RANK"`
+}
+
+// toMainBusiness 转换为主营构成，未知的分类代码原样保留
+func (r mainBusinessRow) toMainBusiness() types.MainBusiness {
+	category, ok := mainBusinessCategories[r.MainopType]
+	if !ok {
+		category = r.MainopType
+	}
+
+	return types.MainBusiness{
+		StockCode:    r.SecurityCode,
+		ReportDate:   eastmoney.FormatDate(r.ReportDate),
+		Category:     category,
+		ItemName:     utils.CleanString(r.ItemName),
+		Revenue:      r.MainBusinessIncome,
+		RevenueRatio: r.MBIRatio,
+		Cost:         r.MainBusinessCost,
+		CostRatio:    r.MBCRatio,
+		Profit:       r.MainBusinessProfit,
+		ProfitRatio:  r.MBRRatio,
+		GrossMargin:  r.GrossProfitRatio,
+		Rank:         r.Rank,
+	}
+}
@@ -0,0 +1,304 @@
+package finance
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/common/eastmoney"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// periodPageSize 全市场报表每页条数
+const periodPageSize = 500
+
+// aShareFilter 仅保留沪深京A股
+const aShareFilter = `(SECURITY_TYPE_CODE in ("058001001","058001008"))`
+
+// periodReport 数据中心全市场定期报告
+type periodReport struct {
+	ReportName string // 报表名称
+	DateColumn string // 报告期字段
+	Filter     string // 附加过滤条件
+}
+
+// 数据中心年报季报，字段少于单只股票的 F10 报表
+var (
+	balancePeriodReport  = periodReport{ReportName: "RPT_DMSK_FN_BALANCE", DateColumn: "REPORT_DATE", Filter: aShareFilter}
+	profitPeriodReport   = periodReport{ReportName: "RPT_DMSK_FN_INCOME", DateColumn: "REPORT_DATE", Filter: aShareFilter}
+	cashFlowPeriodReport = periodReport{ReportName: "RPT_DMSK_FN_CASHFLOW", DateColumn: "REPORT_DATE", Filter: aShareFilter}
+	corePeriodReport     = periodReport{ReportName: "RPT_LICO_FN_CPD", DateColumn: "REPORTDATE"}
+)
+
+// StreamBalanceByPeriod 分页获取全市场指定报告期的资产负债表，每获取一页调用一次 handle
+// 数据来自东方财富数据中心年报季报，仅含总资产、总负债、货币资金、应收账款、存货、应付账款、所有者权益，其余字段为0
+// 结果按股票代码升序排列，startPage 从 1 开始，中断后可传入已处理页码 + 1 续传；handle 返回错误时停止
+// 披露期内新披露的公司会插入已有排序位置使后续页整体后移，续传只有在报告期披露截止后
+// （一季报 4 月 30 日、中报 8 月 31 日、三季报 10 月 31 日、年报次年 4 月 30 日）才能保证不重不漏
+func (s *StockFinance) StreamBalanceByPeriod(reportDate string, startPage int, handle func(page, pages int, rows []types.BalanceSheet) error) error {
+	return streamByPeriod(s, balancePeriodReport, reportDate, startPage, periodBalanceRow.toBalanceSheet, handle)
+}
+
+// StreamProfitByPeriod 分页获取全市场指定报告期的利润表，用法同 StreamBalanceByPeriod
+// 仅含营业总收入、营业总成本、三项费用、营业利润、利润总额、所得税和归母净利润，其余字段为0
+func (s *StockFinance) StreamProfitByPeriod(reportDate string, startPage int, handle func(page, pages int, rows []types.Profit) error) error {
+	return streamByPeriod(s, profitPeriodReport, reportDate, startPage, periodProfitRow.toProfit, handle)
+}
+
+// StreamCashFlowByPeriod 分页获取全市场指定报告期的现金流量表，用法同 StreamBalanceByPeriod
+// 仅含三类活动现金流量净额、销售收现、职工薪酬、购建长期资产支出和现金净增加额，其余字段为0
+func (s *StockFinance) StreamCashFlowByPeriod(reportDate string, startPage int, handle func(page, pages int, rows []types.CashFlow) error) error {
+	return streamByPeriod(s, cashFlowPeriodReport, reportDate, startPage, periodCashFlowRow.toCashFlow, handle)
+}
+
+// StreamCoreIndexByPeriod 分页获取全市场指定报告期的核心财务数据，用法同 StreamBalanceByPeriod
+// 数据来自业绩报表，仅含每股指标、营收和归母净利润及其增速、加权ROE和毛利率，其余字段为0
+func (s *StockFinance) StreamCoreIndexByPeriod(reportDate string, startPage int, handle func(page, pages int, rows []types.FinanceCore) error) error {
+	return streamByPeriod(s, corePeriodReport, reportDate, startPage, periodCoreRow.toFinanceCore, handle)
+}
+
+// GetBalanceByPeriod 获取全市场指定报告期的资产负债表，如 2024-09-30
+func (s *StockFinance) GetBalanceByPeriod(reportDate string) ([]types.BalanceSheet, error) {
+	var all []types.BalanceSheet
+	err := s.StreamBalanceByPeriod(reportDate, 1, func(_, _ int, rows []types.BalanceSheet) error {
+		all = append(all, rows...)
+		return nil
+	})
+	return all, err
+}
+
+// GetProfitByPeriod 获取全市场指定报告期的利润表，如 2024-09-30
+func (s *StockFinance) GetProfitByPeriod(reportDate string) ([]types.Profit, error) {
+	var all []types.Profit
+	err := s.StreamProfitByPeriod(reportDate, 1, func(_, _ int, rows []types.Profit) error {
+		all = append(all, rows...)
+		return nil
+	})
+	return all, err
+}
+
+// GetCashFlowByPeriod 获取全市场指定报告期的现金流量表，如 2024-09-30
+func (s *StockFinance) GetCashFlowByPeriod(reportDate string) ([]types.CashFlow, error) {
+	var all []types.CashFlow
+	err := s.StreamCashFlowByPeriod(reportDate, 1, func(_, _ int, rows []types.CashFlow) error {
+		all = append(all, rows...)
+		return nil
+	})
+	return all, err
+}
+
+// GetCoreIndexByPeriod 获取全市场指定报告期的核心财务数据，如 2024-09-30
+func (s *StockFinance) GetCoreIndexByPeriod(reportDate string) ([]types.FinanceCore, error) {
+	var all []types.FinanceCore
+	err := s.StreamCoreIndexByPeriod(reportDate, 1, func(_, _ int, rows []types.FinanceCore) error {
+		all = append(all, rows...)
+		return nil
+	})
+	return all, err
+}
+
+// streamByPeriod 按报告期过滤并逐页获取数据中心全市场报告
+func streamByPeriod[R any, T any](s *StockFinance, report periodReport, reportDate string, startPage int, convert func(R) T, handle func(page, pages int, rows []T) error) error {
+	date, err := utils.FormatDate(reportDate)
+	if err != nil || date == "" {
+		return errors.ErrInvalidDateFormat
+	}
+
+	if startPage < 1 {
+		startPage = 1
+	}
+
+	// 按股票代码排序，报告期披露截止后分页结果稳定
+	query := eastmoney.Query{
+		URL:         s.datacenterURL,
+		ReportName:  report.ReportName,
+		Filter:      report.Filter + fmt.Sprintf("(%s='%s')", report.DateColumn, date),
+		SortColumns: "SECURITY_CODE",
+		SortTypes:   "1",
+		PageSize:    periodPageSize,
+	}
+
+	for page := startPage; ; page++ {
+		result, err := eastmoney.FetchPage(s.client, query, page)
+		if err != nil {
+			if page == startPage {
+				return err
+			}
+			return errors.WrapError(err, fmt.Sprintf("获取 %s 第 %d 页失败，可从该页续传", date, page))
+		}
+
+		var rows []R
+		if err := json.Unmarshal(result.Data, &rows); err != nil {
+			return errors.NewADataError(errors.ErrParseResponseFailed.Code, "JSON解析失败", err.Error())
+		}
+
+		converted := make([]T, 0, len(rows))
+		for _, row := range rows {
+			converted = append(converted, convert(row))
+		}
+
+		if err := handle(page, result.Pages, converted); err != nil {
+			return err
+		}
+
+		if page >= result.Pages || len(rows) == 0 {
+			return nil
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// periodReportType 根据报告期推断报告类型
+func periodReportType(date string) string {
+	_, quarter := quarterOf(date)
+	return [...]string{"", "一季报", "中报", "三季报", "年报"}[quarter]
+}
+
+// periodBalanceRow 数据中心资产负债表原始字段
+type periodBalanceRow struct {
+	SecurityCode     string  `json:"SECURITY_CODE"`
+	ReportDate       string  `json:"REPORT_DATE"`
+	NoticeDate       string  `json:"NOTICE_DATE"`
+	TotalAssets      float64 `json:"TOTAL_ASSETS"`
+	MonetaryFunds    float64 `json:"MONETARYFUNDS"`
+	AccountsRece     float64 `json:"ACCOUNTS_RECE"`
+	Inventory        float64 `json:"INVENTORY"`
+	TotalLiabilities float64 `json:"TOTAL_LIABILITIES"`
+	AccountsPayable  float64 `json:"ACCOUNTS_PAYABLE"`
+	TotalEquity      float64 `json:"TOTAL_EQUITY"`
+}
+
+// toBalanceSheet 转换为资产负债表
+func (r periodBalanceRow) toBalanceSheet() types.BalanceSheet {
+	date := eastmoney.FormatDate(r.ReportDate)
+	return types.BalanceSheet{
+		StockCode:              r.SecurityCode,
+		ReportDate:             date,
+		ReportType:             periodReportType(date),
+		NoticeDate:             eastmoney.FormatDate(r.NoticeDate),
+		TotalAssets:            r.TotalAssets,
+		CashAndCashEquivalents: r.MonetaryFunds,
+		AccountsReceivable:     r.AccountsRece,
+		Inventory:              r.Inventory,
+		TotalLiabilities:       r.TotalLiabilities,
+		AccountsPayable:        r.AccountsPayable,
+		TotalEquity:            r.TotalEquity,
+	}
+}
+
+// periodProfitRow 数据中心利润表原始字段
+type periodProfitRow struct {
+	SecurityCode       string  `json:"SECURITY_CODE"`
+	ReportDate         string  `json:"REPORT_DATE"`
+	NoticeDate         string  `json:"NOTICE_DATE"`
+	TotalOperateIncome float64 `json:"TOTAL_OPERATE_INCOME"`
+	TotalOperateCost   float64 `json:"TOTAL_OPERATE_COST"`
+	OperateCost        float64 `json:"OPERATE_COST"`
+	SaleExpense        float64 `json:"SALE_EXPENSE"`
+	ManageExpense      float64 `json:"MANAGE_EXPENSE"`
+	FinanceExpense     float64 `json:"FINANCE_EXPENSE"`
+	OperateProfit      float64 `json:"OPERATE_PROFIT"`
+	TotalProfit        float64 `json:"TOTAL_PROFIT"`
+	IncomeTax          float64 `json:"INCOME_TAX"`
+	ParentNetprofit    float64 `json:"PARENT_NETPROFIT"`
+}
+
+// toProfit 转换为利润表
+func (r periodProfitRow) toProfit() types.Profit {
+	date := eastmoney.FormatDate(r.ReportDate)
+	return types.Profit{
+		StockCode:             r.SecurityCode,
+		ReportDate:            date,
+		ReportType:            periodReportType(date),
+		NoticeDate:            eastmoney.FormatDate(r.NoticeDate),
+		TotalOperatingRevenue: r.TotalOperateIncome,
+		TotalOperatingCost:    r.TotalOperateCost,
+		OperatingCost:         r.OperateCost,
+		SalesExpense:          r.SaleExpense,
+		AdminExpense:          r.ManageExpense,
+		FinExpense:            r.FinanceExpense,
+		OperatingProfit:       r.OperateProfit,
+		TotalProfit:           r.TotalProfit,
+		IncomeTaxExpense:      r.IncomeTax,
+		NetProfitAttrSH:       r.ParentNetprofit,
+	}
+}
+
+// periodCashFlowRow 数据中心现金流量表原始字段
+type periodCashFlowRow struct {
+	SecurityCode       string  `json:"SECURITY_CODE"`
+	ReportDate         string  `json:"REPORT_DATE"`
+	NoticeDate         string  `json:"NOTICE_DATE"`
+	NetcashOperate     float64 `json:"NETCASH_OPERATE"`
+	SalesServices      float64 `json:"SALES_SERVICES"`
+	PayStaffCash       float64 `json:"PAY_STAFF_CASH"`
+	NetcashInvest      float64 `json:"NETCASH_INVEST"`
+	ConstructLongAsset float64 `json:"CONSTRUCT_LONG_ASSET"`
+	NetcashFinance     float64 `json:"NETCASH_FINANCE"`
+	CCEAdd             float64 `json:"CCE_ADD"`
+}
+
+// toCashFlow 转换为现金流量表
+func (r periodCashFlowRow) toCashFlow() types.CashFlow {
+	date := eastmoney.FormatDate(r.ReportDate)
+	return types.CashFlow{
+		StockCode:            r.SecurityCode,
+		ReportDate:           date,
+		ReportType:           periodReportType(date),
+		NoticeDate:           eastmoney.FormatDate(r.NoticeDate),
+		NetCashFlowsOperAct:  r.NetcashOperate,
+		SalesServicesRender:  r.SalesServices,
+		PaymentStaffBenefits: r.PayStaffCash,
+		NetCashFlowsInvAct:   r.NetcashInvest,
+		PurchaseAssets:       r.ConstructLongAsset,
+		NetCashFlowsFinAct:   r.NetcashFinance,
+		NetIncreaseCash:      r.CCEAdd,
+	}
+}
+
+// periodCoreRow 数据中心业绩报表原始字段
+type periodCoreRow struct {
+	SecurityCode       string  `json:"SECURITY_CODE"`
+	SecurityNameAbbr   string  `json:"SECURITY_NAME_ABBR"`
+	ReportDate         string  `json:"REPORTDATE"`
+	NoticeDate         string  `json:"NOTICE_DATE"`
+	BasicEPS           float64 `json:"BASIC_EPS"`
+	DeductBasicEPS     float64 `json:"DEDUCT_BASIC_EPS"`
+	BPS                float64 `json:"BPS"`
+	MGJYXJJE           float64 `json:"MGJYXJJE"`
+	TotalOperateIncome float64 `json:"TOTAL_OPERATE_INCOME"`
+	ParentNetprofit    float64 `json:"PARENT_NETPROFIT"`
+	YSTZ               float64 `json:"YSTZ"`
+	YSHZ               float64 `json:"YSHZ"`
+	SJLTZ              float64 `json:"SJLTZ"`
+	SJLHZ              float64 `json:"SJLHZ"`
+	WeightavgROE       float64 `json:"WEIGHTAVG_ROE"`
+	XSMLL              float64 `json:"XSMLL"`
+}
+
+// toFinanceCore 转换为核心财务数据
+func (r periodCoreRow) toFinanceCore() types.FinanceCore {
+	date := eastmoney.FormatDate(r.ReportDate)
+	return types.FinanceCore{
+		StockCode:       r.SecurityCode,
+		ShortName:       utils.CleanString(r.SecurityNameAbbr),
+		ReportDate:      date,
+		ReportType:      periodReportType(date),
+		NoticeDate:      eastmoney.FormatDate(r.NoticeDate),
+		BasicEPS:        r.BasicEPS,
+		NonGaapEPS:      r.DeductBasicEPS,
+		NetAssetPS:      r.BPS,
+		OperCFPS:        r.MGJYXJJE,
+		TotalRev:        r.TotalOperateIncome,
+		NetProfitAttrSH: r.ParentNetprofit,
+		TotalRevYoYGR:   r.YSTZ,
+		TotalRevQoQGR:   r.YSHZ,
+		NetProfitYoYGR:  r.SJLTZ,
+		NetProfitQoQGR:  r.SJLHZ,
+		ROEWtd:          r.WeightavgROE,
+		GrossMargin:     r.XSMLL,
+	}
+}
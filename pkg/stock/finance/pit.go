@@ -0,0 +1,149 @@
+package finance
+
+import (
+	"sort"
+
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// GetBalanceAsOf 获取截至指定日期已公告的资产负债表，按报告期升序排列
+//
+// 结果只排除了截至该日期尚未公告的报告期，并非严格的时点数据：东方财富 F10 接口每个报告期只返回
+// 最新一版数据，且沿用首次公告日期，更正或追溯调整后的数字会被当作首次公告时即已可见。
+// 回测中对更正敏感的场景需自行保存各时点抓取的数据，再用 BalanceAsOf 筛选
+func (s *StockFinance) GetBalanceAsOf(stockCode, date string) ([]types.BalanceSheet, error) {
+	asOf, err := utils.FormatDate(date)
+	if err != nil || asOf == "" {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	balances, err := s.GetBalance(stockCode)
+	if err != nil {
+		return nil, err
+	}
+	return BalanceAsOf(balances, asOf), nil
+}
+
+// GetProfitAsOf 获取截至指定日期已公告的利润表，按报告期升序排列
+// 同一报告期只有最新一版数据，更正后的数字不按时点区分，限制同 GetBalanceAsOf
+func (s *StockFinance) GetProfitAsOf(stockCode, date string) ([]types.Profit, error) {
+	asOf, err := utils.FormatDate(date)
+	if err != nil || asOf == "" {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	profits, err := s.GetProfit(stockCode)
+	if err != nil {
+		return nil, err
+	}
+	return ProfitAsOf(profits, asOf), nil
+}
+
+// GetCashFlowAsOf 获取截至指定日期已公告的现金流量表，按报告期升序排列
+// 同一报告期只有最新一版数据，更正后的数字不按时点区分，限制同 GetBalanceAsOf
+func (s *StockFinance) GetCashFlowAsOf(stockCode, date string) ([]types.CashFlow, error) {
+	asOf, err := utils.FormatDate(date)
+	if err != nil || asOf == "" {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	flows, err := s.GetCashFlow(stockCode)
+	if err != nil {
+		return nil, err
+	}
+	return CashFlowAsOf(flows, asOf), nil
+}
+
+// GetCoreIndexAsOf 获取截至指定日期已公告的核心财务数据，按报告期升序排列
+// 同一报告期只有最新一版数据，更正后的数字不按时点区分，限制同 GetBalanceAsOf
+func (s *StockFinance) GetCoreIndexAsOf(stockCode, date string) ([]types.FinanceCore, error) {
+	asOf, err := utils.FormatDate(date)
+	if err != nil || asOf == "" {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	cores, err := s.GetCoreIndex(stockCode)
+	if err != nil {
+		return nil, err
+	}
+	return CoreIndexAsOf(cores, asOf), nil
+}
+
+// BalanceAsOf 筛选公告日期不晚于 date 的资产负债表，输入中同一报告期有多个版本时保留当时最新的版本
+func BalanceAsOf(balances []types.BalanceSheet, date string) []types.BalanceSheet {
+	return asOf(balances, date, balancePeriod)
+}
+
+// ProfitAsOf 筛选公告日期不晚于 date 的利润表，同一报告期保留当时最新的版本
+func ProfitAsOf(profits []types.Profit, date string) []types.Profit {
+	return asOf(profits, date, profitPeriod)
+}
+
+// CashFlowAsOf 筛选公告日期不晚于 date 的现金流量表，同一报告期保留当时最新的版本
+func CashFlowAsOf(flows []types.CashFlow, date string) []types.CashFlow {
+	return asOf(flows, date, cashFlowPeriod)
+}
+
+// CoreIndexAsOf 筛选公告日期不晚于 date 的核心财务数据，同一报告期保留当时最新的版本
+func CoreIndexAsOf(cores []types.FinanceCore, date string) []types.FinanceCore {
+	return asOf(cores, date, corePeriod)
+}
+
+// ProfitVersions 返回输入中指定报告期的全部利润表版本，按公告日期升序排列
+// GetProfit 每个报告期只返回最新一版，对其结果调用时至多一条记录；需要比较原始与更正版本时，
+// 应传入调用方在不同时点自行保存的数据
+func ProfitVersions(profits []types.Profit, reportDate string) []types.Profit {
+	return versions(profits, reportDate, profitPeriod)
+}
+
+// BalanceVersions 返回指定报告期的全部资产负债表版本，按公告日期升序排列
+func BalanceVersions(balances []types.BalanceSheet, reportDate string) []types.BalanceSheet {
+	return versions(balances, reportDate, balancePeriod)
+}
+
+// CashFlowVersions 返回指定报告期的全部现金流量表版本，按公告日期升序排列
+func CashFlowVersions(flows []types.CashFlow, reportDate string) []types.CashFlow {
+	return versions(flows, reportDate, cashFlowPeriod)
+}
+
+// asOf 按公告日期截断后按报告期去重，缺少公告日期的记录无法确认可见时间，一律剔除
+func asOf[T any](rows []T, date string, period periodFunc[T]) []T {
+	var known []T
+	for _, row := range rows {
+		_, notice := period(row)
+		if len(notice) > 10 {
+			notice = notice[:10]
+		}
+		if notice != "" && notice <= date {
+			known = append(known, row)
+		}
+	}
+
+	periods, dates := latestByPeriod(known, period)
+
+	result := make([]T, 0, len(dates))
+	for _, reportDate := range dates {
+		result = append(result, periods[reportDate])
+	}
+	return result
+}
+
+// versions 返回同一报告期的全部记录，按公告日期升序排列
+func versions[T any](rows []T, reportDate string, period periodFunc[T]) []T {
+	var result []T
+	for _, row := range rows {
+		date, _ := period(row)
+		if date == reportDate {
+			result = append(result, row)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		_, a := period(result[i])
+		_, b := period(result[j])
+		return a < b
+	})
+	return result
+}
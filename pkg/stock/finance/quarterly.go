@@ -0,0 +1,284 @@
+package finance
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// 推导结果的报告类型
+const (
+	ReportTypeSingleQuarter = "单季度"
+	ReportTypeTTM           = "TTM"
+)
+
+// SingleQuarterProfit 将累计利润表转换为单季度数据，按报告期升序排列
+// 单季度 = 本期累计 - 上一季度累计，一季度即为本身；缺少上一季度时跳过该期
+// 同一报告期存在多条记录（如追溯调整）时取公告日期最新的一条
+func SingleQuarterProfit(profits []types.Profit) []types.Profit {
+	return singleQuarter(profits, profitOps)
+}
+
+// TTMProfit 将累计利润表转换为滚动十二个月数据，按报告期升序排列
+// TTM = 本期累计 + 上年年报 - 上年同期累计，年报即为本身；缺少上年年报或上年同期时跳过该期
+func TTMProfit(profits []types.Profit) []types.Profit {
+	return ttm(profits, profitOps)
+}
+
+// SingleQuarterCashFlow 将累计现金流量表转换为单季度数据，期初现金取上一季度期末余额
+func SingleQuarterCashFlow(flows []types.CashFlow) []types.CashFlow {
+	return singleQuarter(flows, cashFlowOps)
+}
+
+// TTMCashFlow 将累计现金流量表转换为滚动十二个月数据，期初现金取上年同期期末余额
+func TTMCashFlow(flows []types.CashFlow) []types.CashFlow {
+	return ttm(flows, cashFlowOps)
+}
+
+// periodFunc 读取记录的报告期（YYYY-MM-DD）和公告日期
+type periodFunc[T any] func(row T) (string, string)
+
+// statementOps 累计报表推导所需的字段操作
+type statementOps[T any] struct {
+	period    periodFunc[T]
+	setPeriod func(row *T, date, reportType string) // 写入规范化的报告期和推导后的报告类型
+	combine   func(a, b T, sign float64) T          // 对流量类字段计算 a + sign × b
+	fix       func(derived *T, current, base T)     // 修正时点类字段，base 为被扣减的期间，可为空
+}
+
+// profitOps 利润表推导规则
+var profitOps = statementOps[types.Profit]{
+	period: profitPeriod,
+	setPeriod: func(row *types.Profit, date, reportType string) {
+		row.ReportDate, row.ReportType = date, reportType
+	},
+	combine: combineProfit,
+}
+
+// cashFlowOps 现金流量表推导规则，期初现金取被扣减期间的期末余额，期末现金取本期
+var cashFlowOps = statementOps[types.CashFlow]{
+	period: cashFlowPeriod,
+	setPeriod: func(row *types.CashFlow, date, reportType string) {
+		row.ReportDate, row.ReportType = date, reportType
+	},
+	combine: combineCashFlow,
+	fix: func(derived *types.CashFlow, current, base types.CashFlow) {
+		derived.CashBeginPeriod = base.CashEndPeriod
+		derived.CashEndPeriod = current.CashEndPeriod
+	},
+}
+
+// singleQuarter 单季度推导
+func singleQuarter[T any](rows []T, ops statementOps[T]) []T {
+	periods, dates := latestByPeriod(rows, ops.period)
+
+	var result []T
+	for _, date := range dates {
+		current := periods[date]
+		year, quarter := quarterOf(date)
+
+		derived := current
+		if quarter > 1 {
+			prev, ok := periods[quarterEnd(year, quarter-1)]
+			if !ok {
+				continue
+			}
+			derived = ops.combine(current, prev, -1)
+			if ops.fix != nil {
+				ops.fix(&derived, current, prev)
+			}
+		}
+
+		ops.setPeriod(&derived, date, ReportTypeSingleQuarter)
+		result = append(result, derived)
+	}
+
+	return result
+}
+
+// ttm 滚动十二个月推导
+func ttm[T any](rows []T, ops statementOps[T]) []T {
+	periods, dates := latestByPeriod(rows, ops.period)
+
+	var result []T
+	for _, date := range dates {
+		current := periods[date]
+		year, quarter := quarterOf(date)
+
+		derived := current
+		if quarter < 4 {
+			annual, ok := periods[quarterEnd(year-1, 4)]
+			if !ok {
+				continue
+			}
+			samePeriod, ok := periods[quarterEnd(year-1, quarter)]
+			if !ok {
+				continue
+			}
+			derived = ops.combine(ops.combine(current, annual, 1), samePeriod, -1)
+			if ops.fix != nil {
+				ops.fix(&derived, current, samePeriod)
+			}
+		}
+
+		ops.setPeriod(&derived, date, ReportTypeTTM)
+		result = append(result, derived)
+	}
+
+	return result
+}
+
+// latestByPeriod 按报告期去重，同一报告期保留公告日期最新的记录，并返回升序排列的季末报告期
+func latestByPeriod[T any](rows []T, period periodFunc[T]) (map[string]T, []string) {
+	periods := make(map[string]T)
+	notices := make(map[string]string)
+
+	for _, row := range rows {
+		date, notice := period(row)
+		if _, quarter := quarterOf(date); quarter == 0 {
+			continue
+		}
+		if existing, ok := notices[date]; ok && existing > notice {
+			continue
+		}
+		periods[date] = row
+		notices[date] = notice
+	}
+
+	dates := make([]string, 0, len(periods))
+	for date := range periods {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+
+	return periods, dates
+}
+
+// quarterOf 解析 YYYY-MM-DD 格式的季末报告期，非季末日期返回季度 0
+func quarterOf(date string) (int, int) {
+	var year, month, day int
+	if _, err := fmt.Sscanf(date, "%d-%d-%d", &year, &month, &day); err != nil {
+		return 0, 0
+	}
+
+	switch {
+	case month == 3 && day == 31:
+		return year, 1
+	case month == 6 && day == 30:
+		return year, 2
+	case month == 9 && day == 30:
+		return year, 3
+	case month == 12 && day == 31:
+		return year, 4
+	default:
+		return year, 0
+	}
+}
+
+// quarterEnd 返回指定季度的季末日期
+func quarterEnd(year, quarter int) string {
+	suffix := [...]string{"", "03-31", "06-30", "09-30", "12-31"}[quarter]
+	return fmt.Sprintf("%d-%s", year, suffix)
+}
+
+// reportPeriod 截取报告期的日期部分
+func reportPeriod(reportDate, noticeDate string) (string, string) {
+	if len(reportDate) > 10 {
+		reportDate = reportDate[:10]
+	}
+	return reportDate, noticeDate
+}
+
+// profitPeriod 读取利润表的报告期和公告日期
+func profitPeriod(row types.Profit) (string, string) {
+	return reportPeriod(row.ReportDate, row.NoticeDate)
+}
+
+// cashFlowPeriod 读取现金流量表的报告期和公告日期
+func cashFlowPeriod(row types.CashFlow) (string, string) {
+	return reportPeriod(row.ReportDate, row.NoticeDate)
+}
+
+// balancePeriod 读取资产负债表的报告期和公告日期
+func balancePeriod(row types.BalanceSheet) (string, string) {
+	return reportPeriod(row.ReportDate, row.NoticeDate)
+}
+
+// corePeriod 读取核心财务数据的报告期和公告日期
+func corePeriod(row types.FinanceCore) (string, string) {
+	return reportPeriod(row.ReportDate, row.NoticeDate)
+}
+
+// combineProfit 对利润表全部数值字段计算 a + sign × b，其余字段取自 a
+func combineProfit(a, b types.Profit, sign float64) types.Profit {
+	result := a
+	result.TotalOperatingRevenue = a.TotalOperatingRevenue + sign*b.TotalOperatingRevenue
+	result.OperatingRevenue = a.OperatingRevenue + sign*b.OperatingRevenue
+	result.InterestIncome = a.InterestIncome + sign*b.InterestIncome
+	result.PremiumsEarned = a.PremiumsEarned + sign*b.PremiumsEarned
+	result.CommissionIncome = a.CommissionIncome + sign*b.CommissionIncome
+	result.TotalOperatingCost = a.TotalOperatingCost + sign*b.TotalOperatingCost
+	result.OperatingCost = a.OperatingCost + sign*b.OperatingCost
+	result.InterestExpense = a.InterestExpense + sign*b.InterestExpense
+	result.CommissionExpense = a.CommissionExpense + sign*b.CommissionExpense
+	result.SurrenderValue = a.SurrenderValue + sign*b.SurrenderValue
+	result.NetCompensationExpense = a.NetCompensationExpense + sign*b.NetCompensationExpense
+	result.NetAmortizationExpense = a.NetAmortizationExpense + sign*b.NetAmortizationExpense
+	result.PolicyBonusExpense = a.PolicyBonusExpense + sign*b.PolicyBonusExpense
+	result.TaxesSurcharges = a.TaxesSurcharges + sign*b.TaxesSurcharges
+	result.SalesExpense = a.SalesExpense + sign*b.SalesExpense
+	result.AdminExpense = a.AdminExpense + sign*b.AdminExpense
+	result.FinExpense = a.FinExpense + sign*b.FinExpense
+	result.AssetImpairmentLoss = a.AssetImpairmentLoss + sign*b.AssetImpairmentLoss
+	result.CreditImpairmentLoss = a.CreditImpairmentLoss + sign*b.CreditImpairmentLoss
+	result.GrossProfit = a.GrossProfit + sign*b.GrossProfit
+	result.OperatingProfit = a.OperatingProfit + sign*b.OperatingProfit
+	result.NonOperatingIncome = a.NonOperatingIncome + sign*b.NonOperatingIncome
+	result.NonOperatingExpense = a.NonOperatingExpense + sign*b.NonOperatingExpense
+	result.LossDisposalAssets = a.LossDisposalAssets + sign*b.LossDisposalAssets
+	result.TotalProfit = a.TotalProfit + sign*b.TotalProfit
+	result.IncomeTaxExpense = a.IncomeTaxExpense + sign*b.IncomeTaxExpense
+	result.NetProfit = a.NetProfit + sign*b.NetProfit
+	result.NetProfitAttrSH = a.NetProfitAttrSH + sign*b.NetProfitAttrSH
+	result.NetProfitMinority = a.NetProfitMinority + sign*b.NetProfitMinority
+	result.NetProfitContinuing = a.NetProfitContinuing + sign*b.NetProfitContinuing
+	result.NetProfitDiscontinued = a.NetProfitDiscontinued + sign*b.NetProfitDiscontinued
+	result.BasicEPS = a.BasicEPS + sign*b.BasicEPS
+	result.DilutedEPS = a.DilutedEPS + sign*b.DilutedEPS
+	return result
+}
+
+// combineCashFlow 对现金流量表全部数值字段计算 a + sign × b，其余字段取自 a
+func combineCashFlow(a, b types.CashFlow, sign float64) types.CashFlow {
+	result := a
+	result.NetCashFlowsOperAct = a.NetCashFlowsOperAct + sign*b.NetCashFlowsOperAct
+	result.CashInflowsOperAct = a.CashInflowsOperAct + sign*b.CashInflowsOperAct
+	result.CashOutflowsOperAct = a.CashOutflowsOperAct + sign*b.CashOutflowsOperAct
+	result.SalesServicesRender = a.SalesServicesRender + sign*b.SalesServicesRender
+	result.TaxRefunds = a.TaxRefunds + sign*b.TaxRefunds
+	result.OtherCashInflowsOper = a.OtherCashInflowsOper + sign*b.OtherCashInflowsOper
+	result.PurchaseGoodsServices = a.PurchaseGoodsServices + sign*b.PurchaseGoodsServices
+	result.PaymentStaffBenefits = a.PaymentStaffBenefits + sign*b.PaymentStaffBenefits
+	result.PaymentsTaxes = a.PaymentsTaxes + sign*b.PaymentsTaxes
+	result.OtherCashOutflowsOper = a.OtherCashOutflowsOper + sign*b.OtherCashOutflowsOper
+	result.NetCashFlowsInvAct = a.NetCashFlowsInvAct + sign*b.NetCashFlowsInvAct
+	result.CashInflowsInvAct = a.CashInflowsInvAct + sign*b.CashInflowsInvAct
+	result.CashOutflowsInvAct = a.CashOutflowsInvAct + sign*b.CashOutflowsInvAct
+	result.RecoveryInvestments = a.RecoveryInvestments + sign*b.RecoveryInvestments
+	result.InvestIncomeReceived = a.InvestIncomeReceived + sign*b.InvestIncomeReceived
+	result.DisposalAssetsReceived = a.DisposalAssetsReceived + sign*b.DisposalAssetsReceived
+	result.PurchaseAssets = a.PurchaseAssets + sign*b.PurchaseAssets
+	result.InvestPayments = a.InvestPayments + sign*b.InvestPayments
+	result.NetCashFlowsFinAct = a.NetCashFlowsFinAct + sign*b.NetCashFlowsFinAct
+	result.CashInflowsFinAct = a.CashInflowsFinAct + sign*b.CashInflowsFinAct
+	result.CashOutflowsFinAct = a.CashOutflowsFinAct + sign*b.CashOutflowsFinAct
+	result.BorrowingsReceived = a.BorrowingsReceived + sign*b.BorrowingsReceived
+	result.IssueSharesBonds = a.IssueSharesBonds + sign*b.IssueSharesBonds
+	result.RepaymentBorrowings = a.RepaymentBorrowings + sign*b.RepaymentBorrowings
+	result.DividendsPaid = a.DividendsPaid + sign*b.DividendsPaid
+	result.NetIncreaseCash = a.NetIncreaseCash + sign*b.NetIncreaseCash
+	result.CashBeginPeriod = a.CashBeginPeriod + sign*b.CashBeginPeriod
+	result.CashEndPeriod = a.CashEndPeriod + sign*b.CashEndPeriod
+	return result
+}
@@ -0,0 +1,150 @@
+package finance
+
+import (
+	"math"
+	"sort"
+
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// RatioFormulas 财务比率各字段的计算公式，键为 types.FinanceRatio 的 json 字段名
+// 流量类数据取 TTM，平均值为本期末与上年同期末余额的均值，缺少上年同期时取本期末余额
+var RatioFormulas = map[string]string{
+	"roe":               "净利润TTM / 平均所有者权益",
+	"net_margin":        "净利润TTM / 营业总收入TTM",
+	"asset_turnover":    "营业总收入TTM / 平均资产总计",
+	"equity_multiplier": "平均资产总计 / 平均所有者权益",
+	"roic":              "(利润总额TTM + 财务费用TTM) × (1 - 所得税费用TTM / 利润总额TTM) / 平均(所有者权益 + 短期借款 + 长期借款)",
+	"gross_margin":      "(营业收入TTM - 营业成本TTM) / 营业收入TTM",
+	"current_ratio":     "流动资产 / 流动负债",
+	"quick_ratio":       "(流动资产 - 存货) / 流动负债",
+	"interest_coverage": "(利润总额TTM + 财务费用TTM) / 财务费用TTM，财务费用不为正时为0",
+	"fcf":               "经营活动现金流量净额TTM - 购建固定资产、无形资产和其他长期资产支付的现金TTM",
+	"accrual_ratio":     "(净利润TTM - 经营活动现金流量净额TTM) / 平均资产总计",
+	"revenue_growth":    "(营业总收入TTM - 上年同期营业总收入TTM) / |上年同期营业总收入TTM|",
+	"net_profit_growth": "(归母净利润TTM - 上年同期归母净利润TTM) / |上年同期归母净利润TTM|",
+	"total_shares":      "报告期末最近一次变更后的总股本",
+	"eps":               "归母净利润TTM / 总股本",
+	"bps":               "所有者权益 / 总股本",
+	"fcfps":             "自由现金流 / 总股本",
+}
+
+// Ratios 根据资产负债表、利润表、现金流量表和股本变动计算各报告期的财务比率，按报告期升序排列
+// 报表为 GetBalance、GetProfit、GetCashFlow 返回的累计数据，仅输出同时具备资产负债表和利润表TTM的报告期
+// 分母为0或缺少所需数据时对应字段为0，计算公式见 RatioFormulas
+func Ratios(balances []types.BalanceSheet, profits []types.Profit, flows []types.CashFlow, shares []types.StockShares) []types.FinanceRatio {
+	balanceByDate, _ := latestByPeriod(balances, balancePeriod)
+	profitByDate := make(map[string]types.Profit)
+	for _, p := range TTMProfit(profits) {
+		profitByDate[p.ReportDate] = p
+	}
+	flowByDate := make(map[string]types.CashFlow)
+	for _, f := range TTMCashFlow(flows) {
+		flowByDate[f.ReportDate] = f
+	}
+
+	sortedShares := make([]types.StockShares, len(shares))
+	copy(sortedShares, shares)
+	sort.Slice(sortedShares, func(i, j int) bool {
+		return sortedShares[i].ChangeDate < sortedShares[j].ChangeDate
+	})
+
+	dates := make([]string, 0, len(profitByDate))
+	for date := range profitByDate {
+		if _, ok := balanceByDate[date]; ok {
+			dates = append(dates, date)
+		}
+	}
+	sort.Strings(dates)
+
+	var ratios []types.FinanceRatio
+	for _, date := range dates {
+		balance := balanceByDate[date]
+		profit := profitByDate[date]
+		flow := flowByDate[date]
+
+		year, quarter := quarterOf(date)
+		lastYear := quarterEnd(year-1, quarter)
+		prevBalance, hasPrevBalance := balanceByDate[lastYear]
+		if !hasPrevBalance {
+			prevBalance = balance
+		}
+
+		avgAssets := (balance.TotalAssets + prevBalance.TotalAssets) / 2
+		avgEquity := (balance.TotalEquity + prevBalance.TotalEquity) / 2
+		avgCapital := (investedCapital(balance) + investedCapital(prevBalance)) / 2
+
+		ebit := profit.TotalProfit + profit.FinExpense
+		taxRate := safeDiv(profit.IncomeTaxExpense, profit.TotalProfit)
+
+		ratio := types.FinanceRatio{
+			StockCode:        balance.StockCode,
+			ReportDate:       date,
+			ROE:              safeDiv(profit.NetProfit, avgEquity),
+			NetMargin:        safeDiv(profit.NetProfit, profit.TotalOperatingRevenue),
+			AssetTurnover:    safeDiv(profit.TotalOperatingRevenue, avgAssets),
+			EquityMultiplier: safeDiv(avgAssets, avgEquity),
+			ROIC:             safeDiv(ebit*(1-taxRate), avgCapital),
+			GrossMargin:      safeDiv(profit.OperatingRevenue-profit.OperatingCost, profit.OperatingRevenue),
+			CurrentRatio:     safeDiv(balance.CurrentAssets, balance.CurrentLiabilities),
+			QuickRatio:       safeDiv(balance.CurrentAssets-balance.Inventory, balance.CurrentLiabilities),
+			FCF:              flow.NetCashFlowsOperAct - flow.PurchaseAssets,
+			AccrualRatio:     safeDiv(profit.NetProfit-flow.NetCashFlowsOperAct, avgAssets),
+		}
+
+		if profit.FinExpense > 0 {
+			ratio.InterestCoverage = ebit / profit.FinExpense
+		}
+
+		if prevProfit, ok := profitByDate[lastYear]; ok {
+			ratio.RevenueGrowth = growth(profit.TotalOperatingRevenue, prevProfit.TotalOperatingRevenue)
+			ratio.NetProfitGrowth = growth(profit.NetProfitAttrSH, prevProfit.NetProfitAttrSH)
+		}
+
+		ratio.TotalShares = sharesAt(sortedShares, date)
+		ratio.EPS = safeDiv(profit.NetProfitAttrSH, ratio.TotalShares)
+		ratio.BPS = safeDiv(balance.TotalEquity, ratio.TotalShares)
+		ratio.FCFPS = safeDiv(ratio.FCF, ratio.TotalShares)
+
+		ratios = append(ratios, ratio)
+	}
+
+	return ratios
+}
+
+// investedCapital 投入资本：所有者权益 + 有息负债
+func investedCapital(balance types.BalanceSheet) float64 {
+	return balance.TotalEquity + balance.ShortTermBorrowing + balance.LongTermBorrowing
+}
+
+// sharesAt 返回截至指定日期最近一次变更后的总股本，shares 须按变更日期升序排列
+func sharesAt(shares []types.StockShares, date string) float64 {
+	total := 0.0
+	for _, s := range shares {
+		changeDate := s.ChangeDate
+		if len(changeDate) > 10 {
+			changeDate = changeDate[:10]
+		}
+		if changeDate > date {
+			break
+		}
+		total = s.TotalShares
+	}
+	return total
+}
+
+// growth 同比增长率，基期为负时按绝对值计算
+func growth(current, base float64) float64 {
+	if base == 0 {
+		return 0
+	}
+	return (current - base) / math.Abs(base)
+}
+
+// safeDiv 分母为0时返回0
+func safeDiv(numerator, denominator float64) float64 {
+	if denominator == 0 {
+		return 0
+	}
+	return numerator / denominator
+}
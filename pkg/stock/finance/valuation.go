@@ -0,0 +1,186 @@
+package finance
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/common/eastmoney"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// GetValuation 获取每日估值序列（PE-TTM、PE-LYR、PB-MRQ、PS-TTM、股息率、总市值、流通市值），按交易日期升序排列
+// 日期为空时不限制起止日期；股息率按除息日在近12个月内的每股派息与当日收盘价计算，计算方法见 DividendYieldTTM
+func (s *StockFinance) GetValuation(stockCode, startDate, endDate string) ([]types.Valuation, error) {
+	if !utils.IsValidStockCode(stockCode) {
+		return nil, errors.ErrInvalidStockCode
+	}
+
+	start, err := utils.FormatDate(startDate)
+	if err != nil {
+		return nil, errors.ErrInvalidDateFormat
+	}
+	end, err := utils.FormatDate(endDate)
+	if err != nil {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	filter := fmt.Sprintf(`(SECURITY_CODE="%s")`, stockCode)
+	if start != "" {
+		filter += fmt.Sprintf("(TRADE_DATE>='%s')", start)
+	}
+	if end != "" {
+		filter += fmt.Sprintf("(TRADE_DATE<='%s')", end)
+	}
+
+	query := eastmoney.Query{
+		URL:         s.datacenterURL,
+		ReportName:  "RPT_VALUEANALYSIS_DET",
+		Columns:     "SECURITY_CODE,TRADE_DATE,CLOSE_PRICE,PE_TTM,PE_LAR,PB_MRQ,PS_TTM,TOTAL_MARKET_CAP,NOTLIMITED_MARKETCAP_A",
+		Filter:      filter,
+		SortColumns: "TRADE_DATE",
+		SortTypes:   "1",
+	}
+
+	rows, err := eastmoney.FetchAll[struct {
+		SecurityCode         string  `json:"SECURITY_CODE"`
+		TradeDate            string  `json:"TRADE_DATE"`
+		ClosePrice           float64 `json:"CLOSE_PRICE"`
+		PETTM                float64 `json:"PE_TTM"`
+		PELAR                float64 `json:"PE_LAR"`
+		PBMRQ                float64 `json:"PB_MRQ"`
+		PSTTM                float64 `json:"PS_TTM"`
+		TotalMarketCap       float64 `json:"TOTAL_MARKET_CAP"`
+		NotlimitedMarketcapA float64 `json:"NOTLIMITED_MARKETCAP_A"`
+	}](s.client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	valuations := make([]types.Valuation, 0, len(rows))
+	for _, item := range rows {
+		valuations = append(valuations, types.Valuation{
+			StockCode:      item.SecurityCode,
+			TradeDate:      eastmoney.FormatDate(item.TradeDate),
+			Close:          item.ClosePrice,
+			PETTM:          item.PETTM,
+			PELYR:          item.PELAR,
+			PBMRQ:          item.PBMRQ,
+			PSTTM:          item.PSTTM,
+			TotalMarketCap: item.TotalMarketCap,
+			FloatMarketCap: item.NotlimitedMarketcapA,
+		})
+	}
+
+	dividends, err := s.stockInfo.GetDividend(stockCode)
+	if err != nil && !errors.IsNoDataFound(err) {
+		return nil, err
+	}
+
+	return DividendYieldTTM(valuations, dividends), nil
+}
+
+// DividendYieldTTM 计算每日股息率：除息日在 (交易日 - 1年, 交易日] 内的每股派息之和 / 收盘价
+// 派息后至交易日之间（含同一方案）有送转股时，每股派息按送转后的股本折算，使其与当日收盘价口径一致；
+// 未确定除息日的分红方案不参与计算
+func DividendYieldTTM(valuations []types.Valuation, dividends []types.Dividend) []types.Valuation {
+	result := make([]types.Valuation, len(valuations))
+	copy(result, valuations)
+
+	for i := range result {
+		day, err := time.Parse("2006-01-02", result[i].TradeDate)
+		if err != nil || result[i].Close <= 0 {
+			continue
+		}
+		tradeDate := result[i].TradeDate
+		since := day.AddDate(-1, 0, 0).Format("2006-01-02")
+
+		cash := 0.0
+		for _, dividend := range dividends {
+			if dividend.CashPerShare <= 0 || dividend.ExDate == "" || dividend.ExDate <= since || dividend.ExDate > tradeDate {
+				continue
+			}
+
+			shares := 1.0
+			for _, other := range dividends {
+				if other.ExDate != "" && other.ExDate >= dividend.ExDate && other.ExDate <= tradeDate {
+					shares *= 1 + other.BonusRatio + other.TransferRatio
+				}
+			}
+			cash += dividend.CashPerShare / shares
+		}
+		result[i].DividendYield = cash / result[i].Close
+	}
+
+	return result
+}
+
+// PercentileRank 计算每个值在其回看窗口（含自身，共 window 个观测值）内的百分位，即不大于该值的观测值占比，取值 (0, 1]
+// window 小于等于0时使用全部历史数据；NaN 不参与计算，对应结果为 NaN
+func PercentileRank(values []float64, window int) []float64 {
+	ranks := make([]float64, len(values))
+
+	for i, value := range values {
+		if math.IsNaN(value) {
+			ranks[i] = math.NaN()
+			continue
+		}
+
+		from := 0
+		if window > 0 && i+1 > window {
+			from = i + 1 - window
+		}
+
+		count, total := 0, 0
+		for _, v := range values[from : i+1] {
+			if math.IsNaN(v) {
+				continue
+			}
+			total++
+			if v <= value {
+				count++
+			}
+		}
+		ranks[i] = float64(count) / float64(total)
+	}
+
+	return ranks
+}
+
+// Percentile 计算分位数，p 取值 [0, 1]，采用线性插值；NaN 不参与计算，无有效数据时返回 NaN
+// 常用于估值通道，如 Percentile(pe, 0.2)、Percentile(pe, 0.5)、Percentile(pe, 0.8)
+func Percentile(values []float64, p float64) float64 {
+	sorted := make([]float64, 0, len(values))
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			sorted = append(sorted, v)
+		}
+	}
+	if len(sorted) == 0 {
+		return math.NaN()
+	}
+	sort.Float64s(sorted)
+
+	p = math.Max(0, math.Min(1, p))
+	pos := p * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(pos-float64(lower))
+}
+
+// ValuationSeries 提取估值序列中的指定指标，非正值（如亏损时的市盈率）记为 NaN，便于直接传入 PercentileRank 和 Percentile
+func ValuationSeries(valuations []types.Valuation, pick func(types.Valuation) float64) []float64 {
+	values := make([]float64, len(valuations))
+	for i, v := range valuations {
+		value := pick(v)
+		if value <= 0 {
+			value = math.NaN()
+		}
+		values[i] = value
+	}
+	return values
+}
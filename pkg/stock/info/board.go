@@ -0,0 +1,77 @@
+package info
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/headers"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// GetBoardConstituentEast 获取东方财富概念或行业板块成分股，boardCode 为板块代码，如 BK0493
+func (s *StockInfo) GetBoardConstituentEast(boardCode string) ([]types.ConceptConstituent, error) {
+	if !utils.IsValidBoardCode(boardCode) {
+		return nil, errors.NewADataError(errors.ErrInvalidStockCode.Code, "无效的东方财富板块代码", boardCode)
+	}
+	boardCode = strings.ToUpper(boardCode)
+
+	baseURL := "https://push2.eastmoney.com/api/qt/clist/get"
+
+	var constituents []types.ConceptConstituent
+	pageSize := 100
+
+	for currPage := 1; currPage < 100; currPage++ {
+		params := map[string]string{
+			"pn":     strconv.Itoa(currPage),
+			"pz":     strconv.Itoa(pageSize),
+			"po":     "1",
+			"np":     "1",
+			"ut":     "bd1d9ddb04089700cf9c27f6f7426281",
+			"fltt":   "2",
+			"invt":   "2",
+			"fid":    "f3",
+			"fs":     "b:" + boardCode + " f:!50",
+			"fields": "f12,f14",
+			"_":      strconv.FormatInt(time.Now().UnixMilli(), 10),
+		}
+
+		var result struct {
+			Data struct {
+				Total int `json:"total"`
+				Diff  []struct {
+					F12 string `json:"f12"` // 股票代码
+					F14 string `json:"f14"` // 股票简称
+				} `json:"diff"`
+			} `json:"data"`
+		}
+
+		err := s.client.GetJSON(baseURL, params, headers.EastMoneyHeaders, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Data.Diff {
+			constituents = append(constituents, types.ConceptConstituent{
+				ConceptCode: boardCode,
+				StockCode:   item.F12,
+				ShortName:   utils.CleanString(item.F14),
+				Source:      "东方财富",
+			})
+		}
+
+		if len(result.Data.Diff) < pageSize || len(constituents) >= result.Data.Total {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if len(constituents) == 0 {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "未找到板块成分股", boardCode)
+	}
+
+	return constituents, nil
+}
@@ -0,0 +1,170 @@
+package info
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/onepiecelover/adata-go/pkg/common/eastmoney"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// GetDividend 获取历次分红送转方案，含未实施的预案，按报告期降序排列
+func (s *StockInfo) GetDividend(stockCode string) ([]types.Dividend, error) {
+	if !utils.IsValidStockCode(stockCode) {
+		return nil, errors.ErrInvalidStockCode
+	}
+
+	query := eastmoney.Query{
+		ReportName:  "RPT_SHAREBONUS_DET",
+		Columns:     "SECURITY_CODE,REPORT_DATE,PLAN_NOTICE_DATE,BONUS_RATIO,IT_RATIO,PRETAX_BONUS_RMB,EQUITY_RECORD_DATE,EX_DIVIDEND_DATE,PAY_CASH_DATE,ASSIGN_PROGRESS,IMPL_PLAN_PROFILE",
+		Filter:      fmt.Sprintf(`(SECURITY_CODE="%s")`, stockCode),
+		SortColumns: "REPORT_DATE",
+		SortTypes:   "-1",
+	}
+
+	// 东方财富按每10股披露送转和派息
+	rows, err := eastmoney.FetchAll[struct {
+		SecurityCode     string  `json:"SECURITY_CODE"`
+		ReportDate       string  `json:"REPORT_DATE"`
+		PlanNoticeDate   string  `json:"PLAN_NOTICE_DATE"`
+		BonusRatio       float64 `json:"BONUS_RATIO"`
+		ITRatio          float64 `json:"IT_RATIO"`
+		PretaxBonusRMB   float64 `json:"PRETAX_BONUS_RMB"`
+		EquityRecordDate string  `json:"EQUITY_RECORD_DATE"`
+		ExDividendDate   string  `json:"EX_DIVIDEND_DATE"`
+		PayCashDate      string  `json:"PAY_CASH_DATE"`
+		AssignProgress   string  `json:"ASSIGN_PROGRESS"`
+		ImplPlanProfile  string  `json:"IMPL_PLAN_PROFILE"`
+	}](s.client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var dividends []types.Dividend
+	for _, item := range rows {
+		dividends = append(dividends, types.Dividend{
+			StockCode:     item.SecurityCode,
+			ReportDate:    eastmoney.FormatDate(item.ReportDate),
+			NoticeDate:    eastmoney.FormatDate(item.PlanNoticeDate),
+			CashPerShare:  item.PretaxBonusRMB / 10,
+			BonusRatio:    item.BonusRatio / 10,
+			TransferRatio: item.ITRatio / 10,
+			RecordDate:    eastmoney.FormatDate(item.EquityRecordDate),
+			ExDate:        eastmoney.FormatDate(item.ExDividendDate),
+			PayDate:       eastmoney.FormatDate(item.PayCashDate),
+			Progress:      item.AssignProgress,
+			Plan:          utils.CleanString(item.ImplPlanProfile),
+		})
+	}
+
+	return dividends, nil
+}
+
+// GetRightsIssue 获取历次配股方案，按除权日降序排列
+func (s *StockInfo) GetRightsIssue(stockCode string) ([]types.RightsIssue, error) {
+	if !utils.IsValidStockCode(stockCode) {
+		return nil, errors.ErrInvalidStockCode
+	}
+
+	query := eastmoney.Query{
+		ReportName:  "RPT_IPO_ALLOTMENT",
+		Columns:     "SECURITY_CODE,NOTICE_DATE,PLACING_RATIO,ISSUE_PRICE,ISSUE_NUM,EQUITY_RECORD_DATE,EX_DIVIDEND_DATE",
+		Filter:      fmt.Sprintf(`(SECURITY_CODE="%s")`, stockCode),
+		SortColumns: "EX_DIVIDEND_DATE",
+		SortTypes:   "-1",
+	}
+
+	// PLACING_RATIO 为每10股配股数
+	rows, err := eastmoney.FetchAll[struct {
+		SecurityCode     string  `json:"SECURITY_CODE"`
+		NoticeDate       string  `json:"NOTICE_DATE"`
+		PlacingRatio     float64 `json:"PLACING_RATIO"`
+		IssuePrice       float64 `json:"ISSUE_PRICE"`
+		IssueNum         float64 `json:"ISSUE_NUM"`
+		EquityRecordDate string  `json:"EQUITY_RECORD_DATE"`
+		ExDividendDate   string  `json:"EX_DIVIDEND_DATE"`
+	}](s.client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []types.RightsIssue
+	for _, item := range rows {
+		issues = append(issues, types.RightsIssue{
+			StockCode:  item.SecurityCode,
+			NoticeDate: eastmoney.FormatDate(item.NoticeDate),
+			Ratio:      item.PlacingRatio / 10,
+			Price:      item.IssuePrice,
+			Shares:     item.IssueNum,
+			RecordDate: eastmoney.FormatDate(item.EquityRecordDate),
+			ExDate:     eastmoney.FormatDate(item.ExDividendDate),
+		})
+	}
+
+	return issues, nil
+}
+
+// GetCorporateActions 获取已实施的分红送转和配股，按除权除息日合并并升序排列
+func (s *StockInfo) GetCorporateActions(stockCode string) ([]types.CorporateAction, error) {
+	dividends, err := s.GetDividend(stockCode)
+	if err != nil {
+		// 从未分红送转的股票（如新股、长期亏损股）没有分红记录
+		if !errors.IsNoDataFound(err) {
+			return nil, err
+		}
+	}
+
+	issues, err := s.GetRightsIssue(stockCode)
+	if err != nil {
+		// 从未配股的股票没有配股记录
+		if !errors.IsNoDataFound(err) {
+			return nil, err
+		}
+	}
+
+	return MergeCorporateActions(stockCode, dividends, issues), nil
+}
+
+// MergeCorporateActions 将分红送转和配股按除权除息日合并，未确定除权日的方案不参与合并
+func MergeCorporateActions(stockCode string, dividends []types.Dividend, issues []types.RightsIssue) []types.CorporateAction {
+	byDate := make(map[string]*types.CorporateAction)
+	get := func(exDate string) *types.CorporateAction {
+		action, ok := byDate[exDate]
+		if !ok {
+			action = &types.CorporateAction{StockCode: stockCode, ExDate: exDate}
+			byDate[exDate] = action
+		}
+		return action
+	}
+
+	for _, dividend := range dividends {
+		if dividend.ExDate == "" {
+			continue
+		}
+		action := get(dividend.ExDate)
+		action.CashPerShare += dividend.CashPerShare
+		action.BonusPerShare += dividend.BonusRatio + dividend.TransferRatio
+	}
+
+	for _, issue := range issues {
+		if issue.ExDate == "" {
+			continue
+		}
+		action := get(issue.ExDate)
+		action.RightsRatio += issue.Ratio
+		action.RightsPrice = issue.Price
+	}
+
+	var actions []types.CorporateAction
+	for _, action := range byDate {
+		actions = append(actions, *action)
+	}
+
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].ExDate < actions[j].ExDate
+	})
+
+	return actions
+}
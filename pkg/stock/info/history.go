@@ -0,0 +1,499 @@
+package info
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/common/client"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/headers"
+	"github.com/onepiecelover/adata-go/pkg/common/symbol"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+const (
+	szseReportURL = "https://www.szse.cn/api/report/ShowReport/data"
+	sseQueryURL   = "https://query.sse.com.cn/commonQuery.do"
+
+	eastListMaxPages   = 100 // 东方财富列表最多翻页数，每页100条
+	sseQueryMaxPages   = 100 // 上交所查询最多翻页数，每页500条
+	szseReportMaxPages = 200 // 深交所报表最多翻页数
+)
+
+// szseTagRe 匹配深交所报表字段中的 HTML 标签
+var szseTagRe = regexp.MustCompile(`<[^>]+>`)
+
+// AllDelistedCode 获取沪深两市终止上市股票列表，任一交易所获取失败时返回错误
+func (s *StockInfo) AllDelistedCode() ([]types.DelistedStock, error) {
+	shStocks, err := s.getDelistedFromSSE()
+	if err != nil {
+		return nil, errors.WrapError(err, "获取上交所终止上市股票失败")
+	}
+
+	szStocks, err := s.getDelistedFromSZSE()
+	if err != nil {
+		return nil, errors.WrapError(err, "获取深交所终止上市股票失败")
+	}
+
+	stocks := append(shStocks, szStocks...)
+	sort.Slice(stocks, func(i, j int) bool {
+		return stocks[i].StockCode < stocks[j].StockCode
+	})
+
+	return stocks, nil
+}
+
+// GetNameHistory 获取股票简称变更及 ST、*ST 状态时间线，按生效日期升序排列
+func (s *StockInfo) GetNameHistory(stockCode string) ([]types.NameChange, error) {
+	if !utils.IsValidStockCode(stockCode) {
+		return nil, errors.ErrInvalidStockCode
+	}
+
+	sym, err := symbol.Parse(stockCode)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []types.NameChange
+	switch sym.Exchange {
+	case symbol.ExchangeSH:
+		changes, err = s.getNameChangesFromSSE(stockCode)
+	case symbol.ExchangeSZ:
+		changes, err = s.getNameChangesFromSZSE(stockCode)
+	default:
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "暂不支持北交所股票简称变更数据", stockCode)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sortNameChanges(changes)
+	return changes, nil
+}
+
+// GetUniverse 获取指定日期处于上市状态的股票代码，包含此后已退市的股票以避免幸存者偏差
+// excludeST 为 true 时剔除当日简称为 ST、*ST 的股票；北交所股票无简称变更数据，按当前简称判断
+func (s *StockInfo) GetUniverse(date string, excludeST bool) ([]string, error) {
+	day, err := utils.FormatDate(date)
+	if err != nil || day == "" {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	listed, err := s.AllCode()
+	if err != nil {
+		return nil, err
+	}
+
+	listDates, err := s.GetListDates()
+	if err != nil {
+		return nil, errors.WrapError(err, "获取上市日期失败")
+	}
+	for i := range listed {
+		if listed[i].ListDate == "" {
+			listed[i].ListDate = listDates[listed[i].StockCode]
+		}
+	}
+
+	delisted, err := s.AllDelistedCode()
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []types.NameChange
+	if excludeST {
+		shChanges, err := s.getNameChangesFromSSE("")
+		if err != nil {
+			return nil, errors.WrapError(err, "获取上交所简称变更失败")
+		}
+
+		szChanges, err := s.getNameChangesFromSZSE("")
+		if err != nil {
+			return nil, errors.WrapError(err, "获取深交所简称变更失败")
+		}
+
+		changes = append(shChanges, szChanges...)
+	}
+
+	return BuildUniverse(day, listed, delisted, changes, excludeST), nil
+}
+
+// BuildUniverse 根据上市、退市和简称变更数据计算指定日期的股票池，结果按代码升序排列
+// 上市日期为空的股票视为已上市；没有简称变更记录的股票按当前简称判断 ST 状态
+func BuildUniverse(date string, listed []types.StockCode, delisted []types.DelistedStock, changes []types.NameChange, excludeST bool) []string {
+	names := make(map[string]string)
+	for _, stock := range listed {
+		if stock.ListDate == "" || stock.ListDate <= date {
+			names[stock.StockCode] = stock.ShortName
+		}
+	}
+
+	for _, stock := range delisted {
+		if (stock.ListDate == "" || stock.ListDate <= date) && stock.DelistDate > date {
+			names[stock.StockCode] = stock.ShortName
+		}
+	}
+
+	byCode := make(map[string][]types.NameChange)
+	for _, change := range changes {
+		byCode[change.StockCode] = append(byCode[change.StockCode], change)
+	}
+
+	var universe []string
+	for code, name := range names {
+		if excludeST {
+			if history, ok := byCode[code]; ok {
+				sortNameChanges(history)
+				name = NameAt(history, date, name)
+			}
+
+			status := StockStatusFromName(name)
+			if status == StatusST || status == StatusStarST {
+				continue
+			}
+		}
+		universe = append(universe, code)
+	}
+
+	sort.Strings(universe)
+	return universe
+}
+
+// NameAt 根据按日期升序排列的简称变更记录返回指定日期的简称
+// 日期早于首条记录时返回首条记录的变更前简称，无记录时返回 fallback
+func NameAt(history []types.NameChange, date, fallback string) string {
+	if len(history) == 0 {
+		return fallback
+	}
+
+	name := history[0].OldName
+	for _, change := range history {
+		if change.EffectiveDate > date {
+			break
+		}
+		name = change.NewName
+	}
+
+	if name == "" {
+		return fallback
+	}
+	return name
+}
+
+// sortNameChanges 按生效日期升序排列简称变更记录
+func sortNameChanges(changes []types.NameChange) {
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].EffectiveDate < changes[j].EffectiveDate
+	})
+}
+
+// GetListDates 从东方财富批量获取当前上市股票的上市日期，键为股票代码，日期格式为 YYYY-MM-DD
+func (s *StockInfo) GetListDates() (map[string]string, error) {
+	baseURL := "https://82.push2.eastmoney.com/api/qt/clist/get"
+
+	listDates := make(map[string]string)
+	pageSize := 100
+
+	for currPage := 1; ; currPage++ {
+		if currPage > eastListMaxPages {
+			return nil, pageLimitError("东方财富上市日期", eastListMaxPages)
+		}
+
+		params := map[string]string{
+			"pn":     strconv.Itoa(currPage),
+			"pz":     strconv.Itoa(pageSize),
+			"po":     "1",
+			"np":     "1",
+			"ut":     "bd1d9ddb04089700cf9c27f6f7426281",
+			"fltt":   "2",
+			"invt":   "2",
+			"fid":    "f12",
+			"fs":     "m:0 t:6,m:0 t:80,m:1 t:2,m:1 t:23,m:0 t:81 s:2048",
+			"fields": "f12,f26",
+			"_":      strconv.FormatInt(time.Now().UnixMilli(), 10),
+		}
+
+		var result struct {
+			Data struct {
+				Diff []struct {
+					F12 string      `json:"f12"` // 股票代码
+					F26 interface{} `json:"f26"` // 上市日期，如 19910403
+				} `json:"diff"`
+			} `json:"data"`
+		}
+
+		err := s.client.GetJSON(baseURL, params, headers.EastMoneyHeaders, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Data.Diff {
+			if value, ok := item.F26.(float64); ok && value > 0 {
+				listDates[item.F12] = normalizeExchangeDate(strconv.FormatInt(int64(value), 10))
+			}
+		}
+
+		if len(result.Data.Diff) < pageSize {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return listDates, nil
+}
+
+// getDelistedFromSSE 从上交所获取终止上市股票
+func (s *StockInfo) getDelistedFromSSE() ([]types.DelistedStock, error) {
+	rows, err := fetchSSEAll[struct {
+		CompanyCode string `json:"COMPANY_CODE"`
+		CompanyAbbr string `json:"COMPANY_ABBR"`
+		ListDate    string `json:"LIST_DATE"`
+		DelistDate  string `json:"DELIST_DATE"`
+	}](s.client, map[string]string{
+		"sqlId":          "COMMON_SSE_CP_GPJCTPZ_GPLB_GP_L",
+		"STOCK_TYPE":     "1,2",
+		"COMPANY_STATUS": "3",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var stocks []types.DelistedStock
+	for _, row := range rows {
+		stocks = append(stocks, types.DelistedStock{
+			StockCode:  row.CompanyCode,
+			ShortName:  utils.CleanString(row.CompanyAbbr),
+			Exchange:   symbol.ExchangeSH,
+			ListDate:   normalizeExchangeDate(row.ListDate),
+			DelistDate: normalizeExchangeDate(row.DelistDate),
+		})
+	}
+
+	return stocks, nil
+}
+
+// getDelistedFromSZSE 从深交所获取终止上市股票
+func (s *StockInfo) getDelistedFromSZSE() ([]types.DelistedStock, error) {
+	rows, err := fetchSZSEReport(s.client, "1793_ssgs", "tab2", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var stocks []types.DelistedStock
+	for _, row := range rows {
+		stocks = append(stocks, types.DelistedStock{
+			StockCode:  row["zqdm"],
+			ShortName:  row["zqjc"],
+			Exchange:   symbol.ExchangeSZ,
+			ListDate:   normalizeExchangeDate(row["ssrq"]),
+			DelistDate: normalizeExchangeDate(row["zzrq"]),
+		})
+	}
+
+	return stocks, nil
+}
+
+// getNameChangesFromSSE 从上交所获取简称变更记录，stockCode 为空时返回全部
+func (s *StockInfo) getNameChangesFromSSE(stockCode string) ([]types.NameChange, error) {
+	rows, err := fetchSSEAll[struct {
+		CompanyCode string `json:"COMPANY_CODE"`
+		ChangeDate  string `json:"CHANGE_DATE"`
+		OldAbbr     string `json:"COMPANY_ABBR_OLD"`
+		NewAbbr     string `json:"COMPANY_ABBR_NEW"`
+	}](s.client, map[string]string{
+		"sqlId":        "COMMON_SSE_CP_GPJCTPZ_GPLB_JCBG_L",
+		"COMPANY_CODE": stockCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []types.NameChange
+	for _, row := range rows {
+		changes = append(changes, newNameChange(row.CompanyCode, symbol.ExchangeSH, row.ChangeDate, row.OldAbbr, row.NewAbbr))
+	}
+
+	return changes, nil
+}
+
+// getNameChangesFromSZSE 从深交所获取简称变更记录，stockCode 为空时返回全部
+func (s *StockInfo) getNameChangesFromSZSE(stockCode string) ([]types.NameChange, error) {
+	var extra map[string]string
+	if stockCode != "" {
+		extra = map[string]string{"txtDMorJC": stockCode}
+	}
+
+	rows, err := fetchSZSEReport(s.client, "SSGSGMXX", "tab2", extra)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []types.NameChange
+	for _, row := range rows {
+		if stockCode != "" && row["zqdm"] != stockCode {
+			continue
+		}
+		changes = append(changes, newNameChange(row["zqdm"], symbol.ExchangeSZ, row["bgrq"], row["bgqjc"], row["bghjc"]))
+	}
+
+	return changes, nil
+}
+
+// newNameChange 构造简称变更记录，状态由变更后简称推断
+func newNameChange(stockCode, exchange, date, oldName, newName string) types.NameChange {
+	newName = utils.CleanString(newName)
+	return types.NameChange{
+		StockCode:     stockCode,
+		Exchange:      exchange,
+		EffectiveDate: normalizeExchangeDate(date),
+		OldName:       utils.CleanString(oldName),
+		NewName:       newName,
+		Status:        StockStatusFromName(newName),
+	}
+}
+
+// normalizeExchangeDate 将交易所返回的日期统一为 YYYY-MM-DD，无法解析时原样返回
+func normalizeExchangeDate(date string) string {
+	if len(date) > 10 {
+		date = date[:10]
+	}
+
+	formatted, err := utils.FormatDate(date)
+	if err != nil {
+		return date
+	}
+	return formatted
+}
+
+// fetchSSEAll 分页获取上交所查询接口的全部数据
+func fetchSSEAll[T any](c *client.Client, query map[string]string) ([]T, error) {
+	var all []T
+	pageSize := 500
+
+	for pageNo := 1; ; pageNo++ {
+		if pageNo > sseQueryMaxPages {
+			return nil, pageLimitError("上交所查询", sseQueryMaxPages)
+		}
+
+		params := map[string]string{
+			"isPagination":       "true",
+			"type":               "inParams",
+			"pageHelp.cacheSize": "1",
+			"pageHelp.pageSize":  strconv.Itoa(pageSize),
+			"pageHelp.pageNo":    strconv.Itoa(pageNo),
+			"pageHelp.beginPage": strconv.Itoa(pageNo),
+			"pageHelp.endPage":   strconv.Itoa(pageNo),
+			"_":                  strconv.FormatInt(time.Now().UnixMilli(), 10),
+		}
+		for key, value := range query {
+			params[key] = value
+		}
+
+		var result struct {
+			PageHelp struct {
+				PageCount int             `json:"pageCount"`
+				Data      json.RawMessage `json:"data"`
+			} `json:"pageHelp"`
+		}
+
+		err := c.GetJSON(sseQueryURL, params, headers.GetSSEHeaders(), &result)
+		if err != nil {
+			return nil, err
+		}
+
+		var rows []T
+		if len(result.PageHelp.Data) > 0 {
+			if err := json.Unmarshal(result.PageHelp.Data, &rows); err != nil {
+				return nil, errors.WrapError(err, "解析上交所数据失败")
+			}
+		}
+		all = append(all, rows...)
+
+		if pageNo >= result.PageHelp.PageCount || len(rows) < pageSize {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return all, nil
+}
+
+// fetchSZSEReport 分页获取深交所报表的全部数据，返回去除 HTML 标签后的字段值
+func fetchSZSEReport(c *client.Client, catalogID, tabKey string, extra map[string]string) ([]map[string]string, error) {
+	var all []map[string]string
+
+	for pageNo := 1; ; pageNo++ {
+		if pageNo > szseReportMaxPages {
+			return nil, pageLimitError("深交所报表 "+catalogID, szseReportMaxPages)
+		}
+
+		params := map[string]string{
+			"SHOWTYPE":  "JSON",
+			"CATALOGID": catalogID,
+			"TABKEY":    tabKey,
+			"PAGENO":    strconv.Itoa(pageNo),
+			"random":    strconv.FormatFloat(rand.Float64(), 'f', 16, 64),
+		}
+		for key, value := range extra {
+			params[key] = value
+		}
+
+		// 响应为各标签页的数组，按 tabkey 选取
+		var result []struct {
+			Metadata struct {
+				TabKey    string `json:"tabkey"`
+				PageCount int    `json:"pagecount"`
+			} `json:"metadata"`
+			Data []map[string]interface{} `json:"data"`
+		}
+
+		err := c.GetJSON(szseReportURL, params, headers.GetCommonHeaders(), &result)
+		if err != nil {
+			return nil, err
+		}
+
+		pageCount := 0
+		for _, tab := range result {
+			if tab.Metadata.TabKey != tabKey && len(result) > 1 {
+				continue
+			}
+
+			pageCount = tab.Metadata.PageCount
+			for _, item := range tab.Data {
+				row := make(map[string]string, len(item))
+				for key, value := range item {
+					if value != nil {
+						row[key] = cleanSZSECell(fmt.Sprint(value))
+					}
+				}
+				all = append(all, row)
+			}
+		}
+
+		if pageNo >= pageCount {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return all, nil
+}
+
+// cleanSZSECell 去除深交所报表字段中的 HTML 标签和多余空白
+func cleanSZSECell(cell string) string {
+	return utils.CleanString(szseTagRe.ReplaceAllString(cell, ""))
+}
+
+// pageLimitError 构造翻页超过上限的错误，避免静默截断数据
+func pageLimitError(source string, maxPages int) error {
+	return errors.NewADataError(errors.ErrRequestFailed.Code, "数据页数超过上限",
+		fmt.Sprintf("%s: 超过%d页", source, maxPages))
+}
@@ -0,0 +1,169 @@
+package info
+
+import (
+	"fmt"
+
+	"github.com/onepiecelover/adata-go/pkg/common/eastmoney"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// GetShareholderCount 获取股东户数历史数据
+func (s *StockInfo) GetShareholderCount(stockCode string) ([]types.ShareholderCount, error) {
+	if !utils.IsValidStockCode(stockCode) {
+		return nil, errors.ErrInvalidStockCode
+	}
+
+	stockCodeWithExchange := utils.CompileExchangeByStockCode(stockCode)
+
+	query := eastmoney.Query{
+		URL:         eastmoney.SecuritiesURL,
+		Source:      "HSF10",
+		Client:      "PC",
+		ReportName:  "RPT_F10_EH_HOLDERNUM",
+		Columns:     "SECUCODE,SECURITY_CODE,END_DATE,HOLDER_TOTAL_NUM,TOTAL_NUM_RATIO,AVG_FREE_SHARES,AVG_FREESHARES_RATIO,AVG_HOLD_AMT,HOLD_RATIO_TOTAL,FREEHOLD_RATIO_TOTAL",
+		Filter:      fmt.Sprintf(`(SECUCODE="%s")`, stockCodeWithExchange),
+		SortColumns: "END_DATE",
+		SortTypes:   "-1",
+	}
+
+	rows, err := eastmoney.FetchAll[struct {
+		SecurityCode       string  `json:"SECURITY_CODE"`
+		EndDate            string  `json:"END_DATE"`
+		HolderTotalNum     float64 `json:"HOLDER_TOTAL_NUM"`
+		TotalNumRatio      float64 `json:"TOTAL_NUM_RATIO"`
+		AvgFreeShares      float64 `json:"AVG_FREE_SHARES"`
+		AvgFreeSharesRatio float64 `json:"AVG_FREESHARES_RATIO"`
+		AvgHoldAmt         float64 `json:"AVG_HOLD_AMT"`
+		HoldRatioTotal     float64 `json:"HOLD_RATIO_TOTAL"`
+		FreeholdRatioTotal float64 `json:"FREEHOLD_RATIO_TOTAL"`
+	}](s.client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var counts []types.ShareholderCount
+	for _, item := range rows {
+		count := types.ShareholderCount{
+			StockCode:          item.SecurityCode,
+			EndDate:            eastmoney.FormatDate(item.EndDate),
+			HolderNum:          int64(item.HolderTotalNum),
+			HolderNumChangePct: item.TotalNumRatio,
+			AvgShares:          item.AvgFreeShares,
+			AvgSharesChangePct: item.AvgFreeSharesRatio,
+			AvgHoldAmount:      item.AvgHoldAmt,
+			Top10Ratio:         item.HoldRatioTotal,
+			Top10FloatRatio:    item.FreeholdRatioTotal,
+		}
+		counts = append(counts, count)
+	}
+
+	return counts, nil
+}
+
+// GetTopHolders 获取历史各报告期十大股东
+func (s *StockInfo) GetTopHolders(stockCode string) ([]types.TopHolder, error) {
+	return s.getTopHolders(stockCode, false)
+}
+
+// GetTopFloatHolders 获取历史各报告期十大流通股东
+func (s *StockInfo) GetTopFloatHolders(stockCode string) ([]types.TopHolder, error) {
+	return s.getTopHolders(stockCode, true)
+}
+
+// getTopHolders 从东方财富获取十大股东或十大流通股东
+func (s *StockInfo) getTopHolders(stockCode string, isFloat bool) ([]types.TopHolder, error) {
+	if !utils.IsValidStockCode(stockCode) {
+		return nil, errors.ErrInvalidStockCode
+	}
+
+	stockCodeWithExchange := utils.CompileExchangeByStockCode(stockCode)
+
+	reportName := "RPT_F10_EH_HOLDERS"
+	ratioColumn := "HOLD_NUM_RATIO"
+	if isFloat {
+		reportName = "RPT_F10_EH_FREEHOLDERS"
+		ratioColumn = "FREE_HOLDNUM_RATIO"
+	}
+
+	query := eastmoney.Query{
+		URL:         eastmoney.SecuritiesURL,
+		Source:      "HSF10",
+		Client:      "PC",
+		ReportName:  reportName,
+		Columns:     fmt.Sprintf("SECUCODE,SECURITY_CODE,END_DATE,HOLDER_RANK,HOLDER_NAME,HOLDER_TYPE,SHARES_TYPE,HOLD_NUM,%s,HOLD_NUM_CHANGE", ratioColumn),
+		Filter:      fmt.Sprintf(`(SECUCODE="%s")`, stockCodeWithExchange),
+		SortColumns: "END_DATE,HOLDER_RANK",
+		SortTypes:   "-1,1",
+	}
+
+	rows, err := eastmoney.FetchAll[struct {
+		SecurityCode     string      `json:"SECURITY_CODE"`
+		EndDate          string      `json:"END_DATE"`
+		HolderRank       int         `json:"HOLDER_RANK"`
+		HolderName       string      `json:"HOLDER_NAME"`
+		HolderType       string      `json:"HOLDER_TYPE"`
+		SharesType       string      `json:"SHARES_TYPE"`
+		HoldNum          float64     `json:"HOLD_NUM"`
+		HoldNumRatio     float64     `json:"HOLD_NUM_RATIO"`
+		FreeHoldNumRatio float64     `json:"FREE_HOLDNUM_RATIO"`
+		HoldNumChange    interface{} `json:"HOLD_NUM_CHANGE"` // 数值或“新进”“不变”等文字
+	}](s.client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var holders []types.TopHolder
+	for _, item := range rows {
+		ratio := item.HoldNumRatio
+		if isFloat {
+			ratio = item.FreeHoldNumRatio
+		}
+
+		change, changeType := parseHoldNumChange(item.HoldNumChange, item.HoldNum)
+
+		holder := types.TopHolder{
+			StockCode:  item.SecurityCode,
+			EndDate:    eastmoney.FormatDate(item.EndDate),
+			IsFloat:    isFloat,
+			Rank:       item.HolderRank,
+			HolderName: utils.CleanString(item.HolderName),
+			HolderType: item.HolderType,
+			ShareType:  item.SharesType,
+			Shares:     item.HoldNum,
+			Ratio:      ratio,
+			Change:     change,
+			ChangeType: changeType,
+		}
+		holders = append(holders, holder)
+	}
+
+	return holders, nil
+}
+
+// parseHoldNumChange 解析持股变动字段，新进股东的变动数量即为当前持股数量
+func parseHoldNumChange(value interface{}, shares float64) (float64, string) {
+	var change float64
+	switch v := value.(type) {
+	case float64:
+		change = v
+	case string:
+		switch v {
+		case "新进":
+			return shares, "新进"
+		case "不变", "":
+			return 0, "不变"
+		}
+		change = utils.ParseFloat(v)
+	}
+
+	switch {
+	case change > 0:
+		return change, "增加"
+	case change < 0:
+		return change, "减少"
+	default:
+		return 0, "不变"
+	}
+}
@@ -0,0 +1,230 @@
+package info
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/common/eastmoney"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/headers"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// indexConstituentSource 指数成分数据源映射
+type indexConstituentSource struct {
+	cnindexCode string // 国证指数网对应代码，支持按月历史快照
+	eastType    string // 东方财富指数成分报表类型，仅提供最新快照
+}
+
+// indexConstituentSources 主要指数的成分数据源
+// 中证指数在深交所有同成分的镜像代码（如沪深300对应399300），历史快照通过国证指数网获取
+var indexConstituentSources = map[string]indexConstituentSource{
+	"000300": {cnindexCode: "399300", eastType: "1"}, // 沪深300
+	"000016": {eastType: "2"},                        // 上证50
+	"000905": {cnindexCode: "399905", eastType: "3"}, // 中证500
+	"000852": {cnindexCode: "399852"},                // 中证1000
+	"399006": {cnindexCode: "399006"},                // 创业板指
+}
+
+// GetIndexConstituent 获取指数成分股及权重
+// date 为空时返回最新快照，否则返回该日期所在月份的月末快照，格式 YYYY-MM-DD
+// 支持沪深300、上证50、中证500、中证1000、创业板指及其他国证指数网收录的 399 开头指数，上证50仅支持最新快照
+func (s *StockInfo) GetIndexConstituent(indexCode, date string) ([]types.IndexConstituent, error) {
+	source, err := getIndexConstituentSource(indexCode)
+	if err != nil {
+		return nil, err
+	}
+
+	day, err := utils.FormatDate(date)
+	if err != nil {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	if source.cnindexCode != "" {
+		if day != "" {
+			month, _ := time.Parse("2006-01-02", day)
+			return s.getIndexConstituentFromCNIndex(indexCode, source.cnindexCode, month)
+		}
+
+		// 当月快照通常在月末后才发布，取不到时回退到上月
+		now := time.Now()
+		constituents, cnErr := s.getIndexConstituentFromCNIndex(indexCode, source.cnindexCode, now)
+		if cnErr != nil {
+			constituents, cnErr = s.getIndexConstituentFromCNIndex(indexCode, source.cnindexCode, now.AddDate(0, -1, 0))
+		}
+		if cnErr == nil || source.eastType == "" {
+			return constituents, cnErr
+		}
+	}
+
+	if day != "" {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "该指数不支持历史成分快照", indexCode)
+	}
+
+	return s.getIndexConstituentFromEast(indexCode, source.eastType)
+}
+
+// GetIndexConstituentHistory 获取指数在日期区间内逐月的成分股及权重快照，结果按快照日期升序排列
+func (s *StockInfo) GetIndexConstituentHistory(indexCode, startDate, endDate string) ([]types.IndexConstituent, error) {
+	source, err := getIndexConstituentSource(indexCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if source.cnindexCode == "" {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "该指数不支持历史成分快照", indexCode)
+	}
+
+	start, err := utils.FormatDate(startDate)
+	if err != nil || start == "" {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	end, err := utils.FormatDate(endDate)
+	if err != nil {
+		return nil, errors.ErrInvalidDateFormat
+	}
+	if end == "" {
+		end = utils.GetCurrentDate()
+	}
+
+	if start > end {
+		return nil, errors.NewADataError(errors.ErrInvalidDateFormat.Code, "开始日期不能晚于结束日期", fmt.Sprintf("%s > %s", start, end))
+	}
+
+	startTime, _ := time.Parse("2006-01-02", start)
+	endTime, _ := time.Parse("2006-01-02", end)
+
+	var history []types.IndexConstituent
+	for month := time.Date(startTime.Year(), startTime.Month(), 1, 0, 0, 0, 0, time.Local); !month.After(endTime); month = month.AddDate(0, 1, 0) {
+		constituents, err := s.getIndexConstituentFromCNIndex(indexCode, source.cnindexCode, month)
+		if err != nil {
+			// 尚未发布的月份没有数据，其余错误直接返回
+			if errors.IsNoDataFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		history = append(history, constituents...)
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if len(history) == 0 {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "未找到指数成分历史快照", fmt.Sprintf("%s %s ~ %s", indexCode, start, end))
+	}
+
+	return history, nil
+}
+
+// getIndexConstituentSource 校验指数代码并返回成分数据源，未登记的 399 开头指数直接使用国证指数网
+func getIndexConstituentSource(indexCode string) (indexConstituentSource, error) {
+	if _, err := strconv.Atoi(indexCode); err != nil || len(indexCode) != 6 {
+		return indexConstituentSource{}, errors.NewADataError(errors.ErrInvalidStockCode.Code, "无效的指数代码", indexCode)
+	}
+
+	if source, ok := indexConstituentSources[indexCode]; ok {
+		return source, nil
+	}
+
+	if indexCode[:3] == "399" {
+		return indexConstituentSource{cnindexCode: indexCode}, nil
+	}
+
+	return indexConstituentSource{}, errors.NewADataError(errors.ErrNoDataFound.Code, "暂不支持该指数的成分数据", indexCode)
+}
+
+// getIndexConstituentFromCNIndex 从国证指数网获取指定月份的指数成分快照
+func (s *StockInfo) getIndexConstituentFromCNIndex(indexCode, cnindexCode string, month time.Time) ([]types.IndexConstituent, error) {
+	baseURL := "http://www.cnindex.com.cn/sample-detail/detail"
+	params := map[string]string{
+		"indexcode": cnindexCode,
+		"dateStr":   month.Format("2006-01"),
+		"pageNum":   "1",
+		"rows":      "5000",
+	}
+
+	var result struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Data    struct {
+			Total int `json:"total"`
+			Rows  []struct {
+				TradeDate string  `json:"trade_date"` // 快照日期
+				SecCode   string  `json:"seccode"`    // 样本代码
+				SecName   string  `json:"secname"`    // 样本简称
+				Industry  string  `json:"industry"`   // 所属行业
+				Weight    float64 `json:"weight"`     // 权重
+			} `json:"rows"`
+		} `json:"data"`
+	}
+
+	err := s.client.GetJSON(baseURL, params, headers.GetCommonHeaders(), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Data.Rows) == 0 {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "未找到指数成分数据", fmt.Sprintf("%s %s", indexCode, params["dateStr"]))
+	}
+
+	var constituents []types.IndexConstituent
+	for _, item := range result.Data.Rows {
+		snapshotDate := eastmoney.FormatDate(item.TradeDate)
+		if snapshotDate == "" {
+			snapshotDate = params["dateStr"]
+		}
+
+		constituents = append(constituents, types.IndexConstituent{
+			IndexCode:    indexCode,
+			SnapshotDate: snapshotDate,
+			StockCode:    item.SecCode,
+			ShortName:    utils.CleanString(item.SecName),
+			Industry:     item.Industry,
+			Weight:       item.Weight,
+			Source:       "国证指数",
+		})
+	}
+
+	return constituents, nil
+}
+
+// getIndexConstituentFromEast 从东方财富获取指数最新成分及权重
+func (s *StockInfo) getIndexConstituentFromEast(indexCode, eastType string) ([]types.IndexConstituent, error) {
+	query := eastmoney.Query{
+		ReportName:  "RPT_INDEX_TS_COMPONENT",
+		Columns:     "SECURITY_CODE,SECURITY_NAME_ABBR,INDUSTRY,WEIGHT",
+		Filter:      fmt.Sprintf(`(TYPE="%s")`, eastType),
+		SortColumns: "WEIGHT",
+		SortTypes:   "-1",
+	}
+
+	rows, err := eastmoney.FetchAll[struct {
+		SecurityCode     string  `json:"SECURITY_CODE"`
+		SecurityNameAbbr string  `json:"SECURITY_NAME_ABBR"`
+		Industry         string  `json:"INDUSTRY"`
+		Weight           float64 `json:"WEIGHT"`
+	}](s.client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotDate := utils.GetCurrentDate()
+
+	var constituents []types.IndexConstituent
+	for _, item := range rows {
+		constituents = append(constituents, types.IndexConstituent{
+			IndexCode:    indexCode,
+			SnapshotDate: snapshotDate,
+			StockCode:    item.SecurityCode,
+			ShortName:    utils.CleanString(item.SecurityNameAbbr),
+			Industry:     item.Industry,
+			Weight:       item.Weight,
+			Source:       "东方财富",
+		})
+	}
+
+	return constituents, nil
+}
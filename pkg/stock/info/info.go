@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/onepiecelover/adata-go/pkg/calendar"
 	"github.com/onepiecelover/adata-go/pkg/common/client"
 	"github.com/onepiecelover/adata-go/pkg/common/errors"
 	"github.com/onepiecelover/adata-go/pkg/common/headers"
@@ -18,13 +19,15 @@ import (
 
 // StockInfo 股票信息结构体
 type StockInfo struct {
-	client *client.Client
+	client   *client.Client
+	thsToken *thsTokenConfig
 }
 
 // NewStockInfo 创建股票信息实例
 func NewStockInfo() *StockInfo {
 	return &StockInfo{
-		client: client.NewClient(),
+		client:   client.NewClient(),
+		thsToken: &thsTokenConfig{},
 	}
 }
 
@@ -235,15 +238,18 @@ func (s *StockInfo) getAllCodeFromSina() ([]types.StockCode, error) {
 	return allCodes, nil
 }
 
-// AllConceptCodeTHS 获取同花顺概念代码列表
-func (s *StockInfo) AllConceptCodeTHS() ([]types.ConceptCode, error) {
-	// 这里需要实现同花顺概念代码获取逻辑
-	// 由于同花顺接口较复杂，这里提供基础框架
-	return nil, errors.NewADataError(50001, "同花顺概念代码获取功能待实现", "")
-}
-
 // AllConceptCodeEast 获取东方财富概念代码列表
 func (s *StockInfo) AllConceptCodeEast() ([]types.ConceptCode, error) {
+	return s.getBoardCodeFromEast("m:90+t:3")
+}
+
+// AllIndustryCodeEast 获取东方财富行业板块代码列表
+func (s *StockInfo) AllIndustryCodeEast() ([]types.ConceptCode, error) {
+	return s.getBoardCodeFromEast("m:90+t:2")
+}
+
+// getBoardCodeFromEast 从东方财富获取板块代码列表，fs 为板块类型过滤条件
+func (s *StockInfo) getBoardCodeFromEast(fs string) ([]types.ConceptCode, error) {
 	baseURL := "https://push2.eastmoney.com/api/qt/clist/get"
 
 	var allConcepts []types.ConceptCode
@@ -258,14 +264,14 @@ func (s *StockInfo) AllConceptCodeEast() ([]types.ConceptCode, error) {
 			"np":     "1",
 			"fields": "f12,f13,f14,f62",
 			"fid":    "f62",
-			"fs":     "m:90+t:3",
+			"fs":     fs,
 		}
 
 		var result struct {
 			Data struct {
 				Diff []struct {
-					F12 string `json:"f12"` // 概念代码
-					F14 string `json:"f14"` // 概念名称
+					F12 string `json:"f12"` // 板块代码
+					F14 string `json:"f14"` // 板块名称
 				} `json:"diff"`
 			} `json:"data"`
 		}
@@ -572,7 +578,8 @@ func (s *StockInfo) GetIndustrySW(stockCode string) ([]types.IndustrySW, error)
 	return industries, nil
 }
 
-// TradeCalendar 获取交易日历
+// TradeCalendar 获取交易日历，包含该年每一个自然日的交易状态，任一月份获取失败时返回错误
+// 离线场景或频繁查询请使用 calendar 包
 func (s *StockInfo) TradeCalendar(year int) ([]types.TradeCalendar, error) {
 	// 如果没有指定年份，默认使用当前年份
 	if year == 0 {
@@ -580,33 +587,5 @@ func (s *StockInfo) TradeCalendar(year int) ([]types.TradeCalendar, error) {
 	}
 
 	// 获取深交所交易日历
-	return s.getCalendarFromSZSE(year)
-}
-
-// getCalendarFromSZSE 从深交所获取交易日历
-func (s *StockInfo) getCalendarFromSZSE(year int) ([]types.TradeCalendar, error) {
-	var allData []types.TradeCalendar
-
-	// 遍历12个月
-	for i := 1; i <= 12; i++ {
-		apiURL := fmt.Sprintf("http://www.szse.cn/api/report/exchange/onepersistenthour/monthList?month=%d-%02d", year, i)
-
-		var result struct {
-			Data []types.TradeCalendar `json:"data"`
-		}
-
-		err := s.client.GetJSON(apiURL, nil, nil, &result)
-		if err != nil {
-			continue
-		}
-
-		// 结果为空跳出循环
-		if len(result.Data) == 0 {
-			break
-		}
-
-		allData = append(allData, result.Data...)
-	}
-
-	return allData, nil
+	return calendar.FetchSZSE(s.client, year)
 }
@@ -0,0 +1,113 @@
+package info
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/common/eastmoney"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/symbol"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// ipoDefaultWindow 未指定日期时新股日历的默认前后跨度（天）
+const ipoDefaultWindow = 30
+
+// GetIPOCalendar 获取申购日期在区间内的新股，包含待申购、待上市和已上市新股，按申购日期降序排列
+// 日期为空时默认取前后30天
+func (s *StockInfo) GetIPOCalendar(startDate, endDate string) ([]types.IPO, error) {
+	start, err := utils.FormatDate(startDate)
+	if err != nil {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	end, err := utils.FormatDate(endDate)
+	if err != nil {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	now := time.Now()
+	if start == "" {
+		start = now.AddDate(0, 0, -ipoDefaultWindow).Format("2006-01-02")
+	}
+	if end == "" {
+		end = now.AddDate(0, 0, ipoDefaultWindow).Format("2006-01-02")
+	}
+
+	if start > end {
+		return nil, errors.NewADataError(errors.ErrInvalidDateFormat.Code, "开始日期不能晚于结束日期", fmt.Sprintf("%s > %s", start, end))
+	}
+
+	query := eastmoney.Query{
+		ReportName:  "RPTA_APP_IPOAPPLY",
+		Columns:     "SECURITY_CODE,SECURITY_NAME,APPLY_CODE,APPLY_DATE,ISSUE_PRICE,AFTER_ISSUE_PE,INDUSTRY_PE_NEW,ONLINE_ISSUE_LWR,LISTING_DATE,LD_OPEN_PREMIUM,LD_CLOSE_CHANGE",
+		Filter:      fmt.Sprintf("(APPLY_DATE>='%s')(APPLY_DATE<='%s')", start, end),
+		SortColumns: "APPLY_DATE,SECURITY_CODE",
+		SortTypes:   "-1,-1",
+	}
+
+	rows, err := eastmoney.FetchAll[struct {
+		SecurityCode   string  `json:"SECURITY_CODE"`
+		SecurityName   string  `json:"SECURITY_NAME"`
+		ApplyCode      string  `json:"APPLY_CODE"`
+		ApplyDate      string  `json:"APPLY_DATE"`
+		IssuePrice     float64 `json:"ISSUE_PRICE"`
+		AfterIssuePE   float64 `json:"AFTER_ISSUE_PE"`
+		IndustryPENew  float64 `json:"INDUSTRY_PE_NEW"`
+		OnlineIssueLWR float64 `json:"ONLINE_ISSUE_LWR"`
+		ListingDate    string  `json:"LISTING_DATE"`
+		LDOpenPremium  float64 `json:"LD_OPEN_PREMIUM"`
+		LDCloseChange  float64 `json:"LD_CLOSE_CHANGE"`
+	}](s.client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var ipos []types.IPO
+	for _, item := range rows {
+		ipo := types.IPO{
+			StockCode:        item.SecurityCode,
+			ShortName:        utils.CleanString(item.SecurityName),
+			ApplyCode:        item.ApplyCode,
+			ApplyDate:        eastmoney.FormatDate(item.ApplyDate),
+			IssuePrice:       item.IssuePrice,
+			IssuePE:          item.AfterIssuePE,
+			IndustryPE:       item.IndustryPENew,
+			LotWinningRate:   item.OnlineIssueLWR,
+			ListingDate:      eastmoney.FormatDate(item.ListingDate),
+			FirstDayOpenPct:  item.LDOpenPremium,
+			FirstDayClosePct: item.LDCloseChange,
+		}
+
+		if sym, err := symbol.Parse(item.SecurityCode); err == nil {
+			ipo.Exchange = sym.Exchange
+			ipo.Board = sym.Board()
+		}
+
+		ipos = append(ipos, ipo)
+	}
+
+	return ipos, nil
+}
+
+// RecentListings 返回在 date 当日及之前 days 个自然日内上市的股票代码，按代码升序排列
+// 用于剔除或筛选次新股，date 格式 YYYY-MM-DD
+func RecentListings(ipos []types.IPO, date string, days int) []string {
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil
+	}
+	since := day.AddDate(0, 0, -days).Format("2006-01-02")
+
+	var codes []string
+	for _, ipo := range ipos {
+		if ipo.ListingDate != "" && ipo.ListingDate > since && ipo.ListingDate <= date {
+			codes = append(codes, ipo.StockCode)
+		}
+	}
+
+	sort.Strings(codes)
+	return codes
+}
@@ -0,0 +1,196 @@
+package info
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/onepiecelover/adata-go/pkg/common/eastmoney"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/headers"
+	"github.com/onepiecelover/adata-go/pkg/common/symbol"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// 股票当前状态
+const (
+	StatusNormal   = "正常"
+	StatusST       = "ST"
+	StatusStarST   = "*ST"
+	StatusDelisted = "退市"
+)
+
+// GetProfile 获取股票基本资料，包括公司名称、行业、发行价、董事长、经营范围及当前状态
+// 主营业务收入构成见 StockFinance.GetMainBusiness
+func (s *StockInfo) GetProfile(stockCode string) (*types.StockProfile, error) {
+	if !utils.IsValidStockCode(stockCode) {
+		return nil, errors.ErrInvalidStockCode
+	}
+
+	sym, err := symbol.Parse(stockCode)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := "https://datacenter.eastmoney.com/securities/api/data/v1/get"
+	params := map[string]string{
+		"reportName":   "RPT_F10_BASIC_ORGINFO",
+		"columns":      "SECUCODE,SECURITY_CODE,SECURITY_NAME_ABBR,ORG_NAME,ORG_NAME_EN,PROVINCE,EM2016,INDUSTRYCSRC1,CHAIRMAN,REG_CAPITAL,ORG_WEB,ORG_PROFILE,BUSINESS_SCOPE",
+		"quoteColumns": "",
+		"filter":       fmt.Sprintf(`(SECUCODE="%s")`, url.QueryEscape(sym.String())),
+		"pageNumber":   "1",
+		"pageSize":     "1",
+		"sortTypes":    "",
+		"sortColumns":  "",
+		"source":       "HSF10",
+		"client":       "PC",
+	}
+
+	var result struct {
+		Success bool `json:"success"`
+		Result  struct {
+			Data []struct {
+				SecurityCode     string  `json:"SECURITY_CODE"`
+				SecurityNameAbbr string  `json:"SECURITY_NAME_ABBR"`
+				OrgName          string  `json:"ORG_NAME"`
+				OrgNameEn        string  `json:"ORG_NAME_EN"`
+				Province         string  `json:"PROVINCE"`
+				EM2016           string  `json:"EM2016"`        // 东方财富行业，如 金融-银行-股份制银行
+				IndustryCSRC     string  `json:"INDUSTRYCSRC1"` // 证监会行业
+				Chairman         string  `json:"CHAIRMAN"`
+				RegCapital       float64 `json:"REG_CAPITAL"` // 注册资本（万元）
+				OrgWeb           string  `json:"ORG_WEB"`
+				OrgProfile       string  `json:"ORG_PROFILE"`
+				BusinessScope    string  `json:"BUSINESS_SCOPE"`
+			} `json:"data"`
+		} `json:"result"`
+	}
+
+	err = s.client.GetJSON(baseURL, params, headers.EastMoneyHeaders, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Success || len(result.Result.Data) == 0 {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "未找到公司基本资料", stockCode)
+	}
+
+	item := result.Result.Data[0]
+
+	industry := item.EM2016
+	if industry == "" {
+		industry = item.IndustryCSRC
+	}
+
+	profile := &types.StockProfile{
+		StockCode:     item.SecurityCode,
+		ShortName:     utils.CleanString(item.SecurityNameAbbr),
+		FullName:      utils.CleanString(item.OrgName),
+		EnglishName:   utils.CleanString(item.OrgNameEn),
+		Exchange:      sym.Exchange,
+		Board:         sym.Board(),
+		Region:        item.Province,
+		Industry:      industry,
+		RegCapital:    item.RegCapital * 10000,
+		Chairman:      utils.CleanString(item.Chairman),
+		Website:       strings.TrimSpace(item.OrgWeb),
+		BusinessScope: strings.TrimSpace(item.BusinessScope),
+		Introduction:  strings.TrimSpace(item.OrgProfile),
+	}
+
+	listDate, ipoPrice, err := s.getIssueInfo(sym)
+	if err != nil {
+		return nil, err
+	}
+	profile.ListDate = listDate
+	profile.IPOPrice = ipoPrice
+
+	profile.Status = StockStatusFromName(profile.ShortName)
+	if profile.Status != StatusDelisted {
+		suspended, err := s.isSuspended(sym.Code)
+		if err != nil {
+			return nil, errors.WrapError(err, "查询停牌状态失败")
+		}
+		profile.Suspended = suspended
+	}
+
+	return profile, nil
+}
+
+// StockStatusFromName 根据股票简称判断状态：退市、*ST、ST 或正常
+func StockStatusFromName(shortName string) string {
+	name := strings.ToUpper(strings.TrimSpace(shortName))
+
+	switch {
+	case strings.HasPrefix(name, "退市") || strings.HasSuffix(name, "退"):
+		return StatusDelisted
+	case utils.IsSTName(name) && strings.Contains(name, "*ST"):
+		return StatusStarST
+	case utils.IsSTName(name):
+		return StatusST
+	default:
+		return StatusNormal
+	}
+}
+
+// getIssueInfo 获取上市日期和发行价
+func (s *StockInfo) getIssueInfo(sym symbol.Symbol) (string, float64, error) {
+	baseURL := "https://datacenter.eastmoney.com/securities/api/data/v1/get"
+	params := map[string]string{
+		"reportName":   "RPT_PCF10_ORG_ISSUEINFO",
+		"columns":      "SECUCODE,SECURITY_CODE,LISTING_DATE,ISSUE_PRICE",
+		"quoteColumns": "",
+		"filter":       fmt.Sprintf(`(SECUCODE="%s")`, url.QueryEscape(sym.String())),
+		"pageNumber":   "1",
+		"pageSize":     "1",
+		"sortTypes":    "",
+		"sortColumns":  "",
+		"source":       "HSF10",
+		"client":       "PC",
+	}
+
+	var result struct {
+		Success bool `json:"success"`
+		Result  struct {
+			Data []struct {
+				ListingDate string  `json:"LISTING_DATE"`
+				IssuePrice  float64 `json:"ISSUE_PRICE"`
+			} `json:"data"`
+		} `json:"result"`
+	}
+
+	err := s.client.GetJSON(baseURL, params, headers.EastMoneyHeaders, &result)
+	if err != nil {
+		return "", 0, err
+	}
+
+	// 部分早期上市股票没有发行信息，不视为错误
+	if !result.Success || len(result.Result.Data) == 0 {
+		return "", 0, nil
+	}
+
+	item := result.Result.Data[0]
+	return eastmoney.FormatDate(item.ListingDate), item.IssuePrice, nil
+}
+
+// isSuspended 查询股票当日是否停牌
+func (s *StockInfo) isSuspended(stockCode string) (bool, error) {
+	query := eastmoney.Query{
+		ReportName: "RPT_CUSTOM_SUSPEND_DATA_INTERFACE",
+		Columns:    "SECURITY_CODE,SUSPEND_START_DATE,SUSPEND_END_DATE",
+		Filter:     fmt.Sprintf(`(MARKET="全部")(DATETIME='%s')(SECURITY_CODE="%s")`, utils.GetCurrentDate(), stockCode),
+		PageSize:   50,
+		MaxPages:   1,
+	}
+
+	page, err := eastmoney.FetchPage(s.client, query, 1)
+	if err != nil {
+		if errors.IsNoDataFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return page.Count > 0, nil
+}
@@ -0,0 +1,296 @@
+package info
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/common/charset"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/headers"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// THSTokenProvider 同花顺 hexin-v 令牌生成函数
+// 令牌由同花顺页面中的 JS 动态生成，调用方可通过 JS 引擎或无头浏览器实现该函数
+type THSTokenProvider func() (string, error)
+
+// thsTokenConfig 同花顺 hexin-v 令牌配置
+type thsTokenConfig struct {
+	mu       sync.RWMutex
+	value    string
+	provider THSTokenProvider
+}
+
+var (
+	thsRowRe        = regexp.MustCompile(`(?s)<tr[^>]*>(.*?)</tr>`)
+	thsCellRe       = regexp.MustCompile(`(?s)<td[^>]*>(.*?)</td>`)
+	thsTagRe        = regexp.MustCompile(`<[^>]+>`)
+	thsConceptRe    = regexp.MustCompile(`gn/detail/code/(\d+)`)
+	thsPageInfoRe   = regexp.MustCompile(`page_info">\s*\d+/(\d+)`)
+	thsStockCodeRe  = regexp.MustCompile(`^\d{6}$`)
+	thsCookieRe     = regexp.MustCompile(`(?:^|;\s*)v=([^;]+)`)
+	thsIndexConcept = regexp.MustCompile(`<a href="http://q\.10jqka\.com\.cn/gn/detail/code/(\d+)/"[^>]*>([^<]+)</a>`)
+)
+
+// SetTHSToken 设置固定的同花顺 hexin-v 令牌
+func (s *StockInfo) SetTHSToken(hexinV string) {
+	s.thsToken.mu.Lock()
+	defer s.thsToken.mu.Unlock()
+
+	s.thsToken.value = hexinV
+}
+
+// SetTHSTokenProvider 设置同花顺 hexin-v 令牌生成函数，令牌失效时会重新调用
+func (s *StockInfo) SetTHSTokenProvider(provider THSTokenProvider) {
+	s.thsToken.mu.Lock()
+	defer s.thsToken.mu.Unlock()
+
+	s.thsToken.provider = provider
+	s.thsToken.value = ""
+}
+
+// AllConceptCodeTHS 获取同花顺概念代码列表
+func (s *StockInfo) AllConceptCodeTHS() ([]types.ConceptCode, error) {
+	// 优先使用分页接口，失败时解析无需令牌的概念首页
+	concepts, err := s.getConceptCodeFromTHSPages()
+	if err == nil && len(concepts) > 0 {
+		return concepts, nil
+	}
+
+	concepts, indexErr := s.getConceptCodeFromTHSIndex()
+	if indexErr != nil {
+		if err != nil {
+			return nil, err
+		}
+		return nil, indexErr
+	}
+
+	return concepts, nil
+}
+
+// GetConceptConstituentTHS 获取同花顺概念成分股，conceptCode 为概念列表中的代码，如 301558
+// 同花顺成分股页面只列出当前成分股，不提供纳入日期
+func (s *StockInfo) GetConceptConstituentTHS(conceptCode string) ([]types.ConceptConstituent, error) {
+	if _, err := strconv.Atoi(conceptCode); err != nil || conceptCode == "" {
+		return nil, errors.NewADataError(errors.ErrInvalidStockCode.Code, "无效的同花顺概念代码", conceptCode)
+	}
+
+	var constituents []types.ConceptConstituent
+	totalPages := 1
+
+	for page := 1; page <= totalPages && page <= 100; page++ {
+		pageURL := fmt.Sprintf("http://q.10jqka.com.cn/gn/detail/field/264648/order/desc/page/%d/ajax/1/code/%s", page, conceptCode)
+
+		html, err := s.getTHSPage(pageURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if page == 1 {
+			totalPages = parseTHSTotalPages(html)
+		}
+
+		for _, cells := range parseTHSRows(html) {
+			constituent := types.ConceptConstituent{
+				ConceptCode: conceptCode,
+				Source:      "同花顺",
+			}
+			for _, cell := range cells {
+				switch {
+				case constituent.StockCode == "" && thsStockCodeRe.MatchString(cell):
+					constituent.StockCode = cell
+				case constituent.StockCode != "" && constituent.ShortName == "":
+					constituent.ShortName = cell
+				}
+			}
+			if constituent.StockCode != "" {
+				constituents = append(constituents, constituent)
+			}
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if len(constituents) == 0 {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "未找到同花顺概念成分股", conceptCode)
+	}
+
+	return constituents, nil
+}
+
+// getConceptCodeFromTHSPages 从同花顺概念分页列表获取概念代码
+func (s *StockInfo) getConceptCodeFromTHSPages() ([]types.ConceptCode, error) {
+	var concepts []types.ConceptCode
+	totalPages := 1
+
+	for page := 1; page <= totalPages && page <= 100; page++ {
+		pageURL := fmt.Sprintf("http://q.10jqka.com.cn/gn/index/field/addtime/order/desc/page/%d/ajax/1/", page)
+
+		html, err := s.getTHSPage(pageURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if page == 1 {
+			totalPages = parseTHSTotalPages(html)
+		}
+
+		for _, row := range thsRowRe.FindAllStringSubmatch(html, -1) {
+			match := thsConceptRe.FindStringSubmatch(row[1])
+			cells := thsCellRe.FindAllStringSubmatch(row[1], -1)
+			if match == nil || len(cells) < 2 {
+				continue
+			}
+
+			concepts = append(concepts, types.ConceptCode{
+				ConceptCode: match[1],
+				ConceptName: cleanTHSCell(cells[1][1]),
+			})
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return concepts, nil
+}
+
+// getConceptCodeFromTHSIndex 从同花顺概念首页的全部概念列表获取概念代码
+func (s *StockInfo) getConceptCodeFromTHSIndex() ([]types.ConceptCode, error) {
+	resp, body, err := s.client.Get("http://q.10jqka.com.cn/gn/", nil, headers.GetTHSHeaders())
+	if err != nil {
+		return nil, err
+	}
+
+	html := charset.DecodeAuto(body, resp.Header.Get("Content-Type"))
+
+	seen := make(map[string]bool)
+	var concepts []types.ConceptCode
+	for _, match := range thsIndexConcept.FindAllStringSubmatch(html, -1) {
+		if seen[match[1]] {
+			continue
+		}
+		seen[match[1]] = true
+
+		concepts = append(concepts, types.ConceptCode{
+			ConceptCode: match[1],
+			ConceptName: utils.CleanString(match[2]),
+		})
+	}
+
+	if len(concepts) == 0 {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "未找到同花顺概念数据", "")
+	}
+
+	return concepts, nil
+}
+
+// getTHSPage 携带 hexin-v 令牌请求同花顺页面，令牌失效时刷新后重试一次
+func (s *StockInfo) getTHSPage(pageURL string) (string, error) {
+	var lastErr error
+
+	for attempt := 0; attempt < 2; attempt++ {
+		token, err := s.getTHSToken(attempt > 0)
+		if err != nil {
+			return "", err
+		}
+
+		resp, body, err := s.client.Get(pageURL, nil, headers.GetTHSAjaxHeaders(token))
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		html := charset.DecodeAuto(body, resp.Header.Get("Content-Type"))
+
+		// 令牌无效时同花顺返回 JS 校验页面而非数据表格
+		if !strings.Contains(html, "<table") {
+			lastErr = fmt.Errorf("响应中未包含数据表格")
+			continue
+		}
+
+		return html, nil
+	}
+
+	return "", errors.NewADataError(errors.ErrDataSourceUnavailable.Code,
+		"同花顺接口请求失败，请通过 SetTHSToken 或 SetTHSTokenProvider 设置有效的 hexin-v 令牌", lastErr.Error())
+}
+
+// getTHSToken 获取 hexin-v 令牌，优先使用生成函数，其次使用同花顺首页下发的 Cookie
+func (s *StockInfo) getTHSToken(refresh bool) (string, error) {
+	s.thsToken.mu.RLock()
+	value, provider := s.thsToken.value, s.thsToken.provider
+	s.thsToken.mu.RUnlock()
+
+	if value != "" && !refresh {
+		return value, nil
+	}
+
+	if provider != nil {
+		token, err := provider()
+		if err != nil {
+			return "", errors.WrapError(err, "同花顺 hexin-v 令牌生成失败")
+		}
+		s.SetTHSToken(token)
+		return token, nil
+	}
+
+	// 未设置生成函数时固定令牌不刷新
+	if value != "" {
+		return value, nil
+	}
+
+	resp, _, err := s.client.Get("http://q.10jqka.com.cn/", nil, headers.GetTHSHeaders())
+	if err != nil {
+		return "", errors.WrapError(err, "同花顺首页请求失败")
+	}
+
+	for _, cookie := range resp.Header.Values("Set-Cookie") {
+		if match := thsCookieRe.FindStringSubmatch(cookie); match != nil {
+			s.SetTHSToken(match[1])
+			return match[1], nil
+		}
+	}
+
+	// 未取得令牌时后续请求只会返回 JS 校验页面，直接报错而不是解析出空结果
+	return "", errors.NewADataError(errors.ErrDataSourceUnavailable.Code,
+		"同花顺首页未下发 hexin-v 令牌，请通过 SetTHSToken 或 SetTHSTokenProvider 设置", "")
+}
+
+// parseTHSRows 解析同花顺表格，返回每行去除标签后的单元格文本
+func parseTHSRows(html string) [][]string {
+	var rows [][]string
+	for _, row := range thsRowRe.FindAllStringSubmatch(html, -1) {
+		var cells []string
+		for _, cell := range thsCellRe.FindAllStringSubmatch(row[1], -1) {
+			cells = append(cells, cleanTHSCell(cell[1]))
+		}
+		if len(cells) > 0 {
+			rows = append(rows, cells)
+		}
+	}
+	return rows
+}
+
+// parseTHSTotalPages 解析同花顺分页信息中的总页数，如 "1/12"
+func parseTHSTotalPages(html string) int {
+	match := thsPageInfoRe.FindStringSubmatch(html)
+	if match == nil {
+		return 1
+	}
+
+	pages, err := strconv.Atoi(match[1])
+	if err != nil || pages < 1 {
+		return 1
+	}
+	return pages
+}
+
+// cleanTHSCell 去除单元格中的 HTML 标签和多余空白
+func cleanTHSCell(cell string) string {
+	return utils.CleanString(thsTagRe.ReplaceAllString(cell, ""))
+}
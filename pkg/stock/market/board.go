@@ -0,0 +1,41 @@
+package market
+
+import (
+	"strings"
+
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// GetBoardMarket 获取东方财富概念或行业板块指数K线行情，params.StockCode 为板块代码，如 BK0493
+// 板块指数无复权概念，AdjustType 不生效
+func (s *StockMarket) GetBoardMarket(params *types.MarketParams) ([]types.MarketData, error) {
+	if params == nil {
+		return nil, errors.NewADataError(errors.ErrInvalidStockCode.Code, "参数不能为空", "")
+	}
+
+	if !utils.IsValidBoardCode(params.StockCode) {
+		return nil, errors.NewADataError(errors.ErrInvalidStockCode.Code, "无效的东方财富板块代码", params.StockCode)
+	}
+	boardCode := strings.ToUpper(params.StockCode)
+
+	return s.getEastKlineBySecID("90."+boardCode, boardCode, params.KType, params.StartDate, params.EndDate)
+}
+
+// ListBoardMarketCurrent 获取多个东方财富概念或行业板块的实时行情，任一板块代码无效时返回错误
+func (s *StockMarket) ListBoardMarketCurrent(boardCodes []string) ([]types.CurrentMarket, error) {
+	if len(boardCodes) == 0 {
+		return nil, errors.NewADataError(errors.ErrInvalidStockCode.Code, "板块代码列表不能为空", "")
+	}
+
+	secIDs := make([]string, 0, len(boardCodes))
+	for _, code := range boardCodes {
+		if !utils.IsValidBoardCode(code) {
+			return nil, errors.NewADataError(errors.ErrInvalidStockCode.Code, "无效的东方财富板块代码", code)
+		}
+		secIDs = append(secIDs, "90."+strings.ToUpper(code))
+	}
+
+	return s.getEastQuotesBySecID(secIDs)
+}
@@ -0,0 +1,304 @@
+package market
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/common/headers"
+	"github.com/onepiecelover/adata-go/pkg/common/symbol"
+	"github.com/onepiecelover/adata-go/pkg/common/utils"
+	"github.com/onepiecelover/adata-go/pkg/types"
+)
+
+// GetIndexMarket 获取指数K线行情，kType: 1-日线, 2-周线, 3-月线，日期为空时返回全部历史
+// 指数所属交易所优先取 index.Exchange，为空时按代码推断
+func (s *StockMarket) GetIndexMarket(index types.IndexCode, startDate, endDate string, kType int) ([]types.MarketData, error) {
+	secID, err := indexSecID(index)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := utils.FormatDate(startDate)
+	if err != nil {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	end, err := utils.FormatDate(endDate)
+	if err != nil {
+		return nil, errors.ErrInvalidDateFormat
+	}
+
+	var startTime, endTime time.Time
+	if start != "" {
+		startTime, _ = time.Parse("2006-01-02", start)
+	}
+	if end != "" {
+		endTime, _ = time.Parse("2006-01-02", end)
+	}
+
+	if kType <= 0 {
+		kType = 1
+	}
+
+	return s.getEastKlineBySecID(secID, index.IndexCode, kType, startTime, endTime)
+}
+
+// GetIndexMarketMin 获取指数当日分时行情
+func (s *StockMarket) GetIndexMarketMin(index types.IndexCode) ([]types.MarketMin, error) {
+	secID, err := indexSecID(index)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := "https://push2.eastmoney.com/api/qt/stock/trends2/get"
+	queryParams := map[string]string{
+		"fields1": "f1,f2,f3,f4,f5,f6,f7,f8,f9,f10,f11,f12,f13",
+		"fields2": "f51,f52,f53,f54,f55,f56,f57,f58",
+		"ut":      "fa5fd1943c7b386f172d6893dbfba10b",
+		"ndays":   "1",
+		"iscr":    "0",
+		"iscca":   "0",
+		"secid":   secID,
+		"_":       strconv.FormatInt(time.Now().UnixMilli(), 10),
+	}
+
+	var result struct {
+		Data struct {
+			PreClose float64  `json:"preClose"`
+			Trends   []string `json:"trends"`
+		} `json:"data"`
+	}
+
+	err = s.client.GetJSON(baseURL, queryParams, headers.EastMoneyHeaders, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Data.Trends) == 0 {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "未找到指数分时数据", secID)
+	}
+
+	var marketMinData []types.MarketMin
+	for _, trend := range result.Data.Trends {
+		data, err := s.parseEastTrendData(trend, index.IndexCode, result.Data.PreClose)
+		if err != nil {
+			continue
+		}
+		marketMinData = append(marketMinData, *data)
+	}
+
+	return marketMinData, nil
+}
+
+// ListIndexMarketCurrent 获取多个指数的实时行情，任一指数代码无效时返回错误
+func (s *StockMarket) ListIndexMarketCurrent(indexes []types.IndexCode) ([]types.CurrentMarket, error) {
+	if len(indexes) == 0 {
+		return nil, errors.NewADataError(errors.ErrInvalidStockCode.Code, "指数代码列表不能为空", "")
+	}
+
+	secIDs := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		secID, err := indexSecID(index)
+		if err != nil {
+			return nil, err
+		}
+		secIDs = append(secIDs, secID)
+	}
+
+	return s.getEastQuotesBySecID(secIDs)
+}
+
+// indexSecID 根据指数所属交易所生成东方财富 secid，Exchange 为空时按代码推断
+func indexSecID(index types.IndexCode) (string, error) {
+	if _, err := strconv.Atoi(index.IndexCode); err != nil || len(index.IndexCode) != 6 {
+		return "", errors.NewADataError(errors.ErrInvalidStockCode.Code, "无效的指数代码", index.IndexCode)
+	}
+
+	exchange := strings.ToUpper(index.Exchange)
+	if exchange == "" {
+		exchange = utils.GetIndexExchange(index.IndexCode)
+	}
+	if exchange == "" {
+		return "", errors.NewADataError(errors.ErrInvalidStockCode.Code, "无法确定指数所属交易所，请设置 Exchange", index.IndexCode)
+	}
+
+	sym, err := symbol.Parse(exchange + index.IndexCode)
+	if err != nil {
+		return "", err
+	}
+
+	return sym.SecID(), nil
+}
+
+// getEastKlineBySecID 按 secid 从东方财富获取指数类K线数据，适用于指数和板块
+func (s *StockMarket) getEastKlineBySecID(secID, code string, kType int, startDate, endDate time.Time) ([]types.MarketData, error) {
+	baseURL := "http://push2his.eastmoney.com/api/qt/stock/kline/get"
+
+	beg := "19900101"
+	if !startDate.IsZero() {
+		beg = startDate.Format("20060102")
+	}
+
+	end := utils.GetCurrentDateForAPI()
+	if !endDate.IsZero() {
+		end = endDate.Format("20060102")
+	}
+
+	klt := strconv.Itoa(kType)
+	if kType < 5 {
+		klt = "10" + klt
+	}
+
+	queryParams := map[string]string{
+		"fields1": "f1,f2,f3,f4,f5,f6",
+		"fields2": "f51,f52,f53,f54,f55,f56,f57,f58,f59,f60,f61",
+		"ut":      "7eea3edcaed734bea9cbfc24409ed989",
+		"klt":     klt,
+		"fqt":     "0",
+		"secid":   secID,
+		"beg":     beg,
+		"end":     end,
+		"_":       strconv.FormatInt(time.Now().UnixMilli(), 10),
+	}
+
+	var result struct {
+		Data struct {
+			Code   string   `json:"code"`
+			Name   string   `json:"name"`
+			Klines []string `json:"klines"`
+		} `json:"data"`
+	}
+
+	err := s.client.GetJSON(baseURL, queryParams, headers.EastMoneyHeaders, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Data.Klines) == 0 {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "未找到行情数据", secID)
+	}
+
+	var marketData []types.MarketData
+	for _, kline := range result.Data.Klines {
+		data, err := parseEastIndexKline(kline, code)
+		if err != nil {
+			continue
+		}
+		marketData = append(marketData, *data)
+	}
+
+	return marketData, nil
+}
+
+// parseEastIndexKline 解析东方财富指数类K线数据
+// 字段依次为：日期、开盘、收盘、最高、最低、成交量、成交额、振幅、涨跌幅、涨跌额、换手率
+func parseEastIndexKline(kline, code string) (*types.MarketData, error) {
+	parts := strings.Split(kline, ",")
+	if len(parts) < 11 {
+		return nil, fmt.Errorf("invalid kline data format")
+	}
+
+	closePrice := utils.ParseFloat(parts[2])
+	change := utils.ParseFloat(parts[9])
+
+	return &types.MarketData{
+		StockCode: code,
+		TradeDate: parts[0],
+		Open:      utils.ParseFloat(parts[1]),
+		Close:     closePrice,
+		High:      utils.ParseFloat(parts[3]),
+		Low:       utils.ParseFloat(parts[4]),
+		Volume:    utils.ParseInt(parts[5]),
+		Amount:    utils.ParseFloat(parts[6]),
+		ChangePct: utils.ParseFloat(parts[8]),
+		Change:    change,
+		Turnover:  utils.ParseFloat(parts[10]),
+		PreClose:  closePrice - change,
+	}, nil
+}
+
+// getEastQuotesBySecID 按 secid 列表从东方财富获取实时行情，适用于指数和板块
+func (s *StockMarket) getEastQuotesBySecID(secIDs []string) ([]types.CurrentMarket, error) {
+	baseURL := "https://push2.eastmoney.com/api/qt/ulist.np/get"
+	params := map[string]string{
+		"fltt":   "2",
+		"invt":   "2",
+		"ut":     "bd1d9ddb04089700cf9c27f6f7426281",
+		"secids": strings.Join(secIDs, ","),
+		"fields": "f2,f3,f4,f5,f6,f8,f12,f13,f14,f15,f16,f17,f18,f20,f21",
+		"_":      strconv.FormatInt(time.Now().UnixMilli(), 10),
+	}
+
+	// 停牌或无数据时数值字段返回 "-"，因此按通用类型解析
+	var result struct {
+		Data struct {
+			Diff []struct {
+				F2  interface{} `json:"f2"`  // 最新点位
+				F3  interface{} `json:"f3"`  // 涨跌幅
+				F4  interface{} `json:"f4"`  // 涨跌额
+				F5  interface{} `json:"f5"`  // 成交量
+				F6  interface{} `json:"f6"`  // 成交额
+				F8  interface{} `json:"f8"`  // 换手率
+				F12 string      `json:"f12"` // 代码
+				F13 int         `json:"f13"` // 市场：1 上海，0 深圳或北京，90 板块
+				F14 string      `json:"f14"` // 名称
+				F15 interface{} `json:"f15"` // 最高
+				F16 interface{} `json:"f16"` // 最低
+				F17 interface{} `json:"f17"` // 开盘
+				F18 interface{} `json:"f18"` // 昨收
+				F20 interface{} `json:"f20"` // 总市值
+				F21 interface{} `json:"f21"` // 流通市值
+			} `json:"diff"`
+		} `json:"data"`
+	}
+
+	err := s.client.GetJSON(baseURL, params, headers.EastMoneyHeaders, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Data.Diff) == 0 {
+		return nil, errors.NewADataError(errors.ErrNoDataFound.Code, "未找到实时行情", strings.Join(secIDs, ","))
+	}
+
+	var quotes []types.CurrentMarket
+	for _, item := range result.Data.Diff {
+		quotes = append(quotes, types.CurrentMarket{
+			StockCode:  item.F12,
+			ShortName:  utils.CleanString(item.F14),
+			Exchange:   eastQuoteExchange(item.F13, item.F12),
+			Price:      utils.ToFloat(item.F2),
+			ChangePct:  utils.ToFloat(item.F3),
+			Change:     utils.ToFloat(item.F4),
+			Volume:     int64(utils.ToFloat(item.F5)),
+			Amount:     utils.ToFloat(item.F6),
+			Turnover:   utils.ToFloat(item.F8),
+			High:       utils.ToFloat(item.F15),
+			Low:        utils.ToFloat(item.F16),
+			Open:       utils.ToFloat(item.F17),
+			PreClose:   utils.ToFloat(item.F18),
+			MarketCap:  utils.ToFloat(item.F20),
+			CircMarket: utils.ToFloat(item.F21),
+		})
+	}
+
+	return quotes, nil
+}
+
+// eastQuoteExchange 根据东方财富市场编号和代码确定交易所，北交所与深交所共用市场编号0，板块返回空
+func eastQuoteExchange(market int, code string) string {
+	switch market {
+	case 1:
+		return symbol.ExchangeSH
+	case 0:
+		if sym, err := symbol.Parse(code); err == nil && sym.Exchange == symbol.ExchangeBJ {
+			return symbol.ExchangeBJ
+		}
+		return symbol.ExchangeSZ
+	default:
+		return ""
+	}
+}
@@ -3,6 +3,7 @@ package market
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/onepiecelover/adata-go/pkg/common/client"
 	"github.com/onepiecelover/adata-go/pkg/common/errors"
 	"github.com/onepiecelover/adata-go/pkg/common/headers"
+	"github.com/onepiecelover/adata-go/pkg/common/symbol"
 	"github.com/onepiecelover/adata-go/pkg/common/utils"
 	"github.com/onepiecelover/adata-go/pkg/types"
 )
@@ -31,7 +33,7 @@ func (s *StockMarket) SetProxy(enabled bool, proxyURL string) {
 	s.client.SetProxy(enabled, proxyURL)
 }
 
-// GetMarket 获取股票K线行情数据
+// GetMarket 获取股票K线行情数据，代码按股票解析（如 000001 为平安银行），指数请使用 GetIndexMarket
 func (s *StockMarket) GetMarket(params *types.MarketParams) ([]types.MarketData, error) {
 	if params == nil {
 		return nil, errors.NewADataError(errors.ErrInvalidStockCode.Code, "参数不能为空", "")
@@ -60,10 +62,9 @@ func (s *StockMarket) GetMarket(params *types.MarketParams) ([]types.MarketData,
 func (s *StockMarket) getMarketFromEast(params *types.MarketParams) ([]types.MarketData, error) {
 	baseURL := "http://push2his.eastmoney.com/api/qt/stock/kline/get"
 
-	// 参数处理
-	secID := "0"
-	if strings.HasPrefix(params.StockCode, "6") {
-		secID = "1"
+	sym, err := symbol.Parse(params.StockCode)
+	if err != nil {
+		return nil, err
 	}
 
 	startDate := "19900101"
@@ -87,7 +88,7 @@ func (s *StockMarket) getMarketFromEast(params *types.MarketParams) ([]types.Mar
 		"ut":      "7eea3edcaed734bea9cbfc24409ed989",
 		"klt":     kType,
 		"fqt":     strconv.Itoa(params.AdjustType),
-		"secid":   fmt.Sprintf("%s.%s", secID, params.StockCode),
+		"secid":   sym.SecID(),
 		"beg":     startDate,
 		"end":     endDate,
 		"_":       strconv.FormatInt(time.Now().UnixMilli(), 10),
@@ -102,7 +103,7 @@ func (s *StockMarket) getMarketFromEast(params *types.MarketParams) ([]types.Mar
 		} `json:"data"`
 	}
 
-	err := s.client.GetJSON(baseURL, queryParams, headers.EastMoneyHeaders, &result)
+	err = s.client.GetJSON(baseURL, queryParams, headers.EastMoneyHeaders, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -124,24 +125,28 @@ func (s *StockMarket) getMarketFromEast(params *types.MarketParams) ([]types.Mar
 }
 
 // parseEastKlineData 解析东方财富K线数据
+// 字段依次为：日期、开盘、收盘、最高、最低、成交量、成交额、振幅、涨跌幅、涨跌额、换手率
 func (s *StockMarket) parseEastKlineData(kline, stockCode string) (*types.MarketData, error) {
 	parts := strings.Split(kline, ",")
 	if len(parts) < 11 {
 		return nil, fmt.Errorf("invalid kline data format")
 	}
 
+	closePrice := utils.ParseFloat(parts[2])
+	change := utils.ParseFloat(parts[9])
+
 	return &types.MarketData{
 		TradeDate: parts[0],
 		Open:      utils.ParseFloat(parts[1]),
-		Close:     utils.ParseFloat(parts[2]),
+		Close:     closePrice,
 		High:      utils.ParseFloat(parts[3]),
 		Low:       utils.ParseFloat(parts[4]),
 		Volume:    utils.ParseInt(parts[5]),
 		Amount:    utils.ParseFloat(parts[6]),
-		Change:    utils.ParseFloat(parts[7]),
+		Change:    change,
 		ChangePct: utils.ParseFloat(parts[8]),
-		Turnover:  utils.ParseFloat(parts[9]),
-		PreClose:  utils.ParseFloat(parts[10]), // 修正为正确的字段索引
+		Turnover:  utils.ParseFloat(parts[10]),
+		PreClose:  math.Round((closePrice-change)*100) / 100,
 		StockCode: stockCode,
 	}, nil
 }
@@ -261,10 +266,9 @@ func (s *StockMarket) GetMarketMin(stockCode string) ([]types.MarketMin, error)
 func (s *StockMarket) getMarketMinFromEast(stockCode string) ([]types.MarketMin, error) {
 	baseURL := "https://push2.eastmoney.com/api/qt/stock/trends2/get"
 
-	// 参数处理
-	secID := "0"
-	if strings.HasPrefix(stockCode, "6") {
-		secID = "1"
+	sym, err := symbol.Parse(stockCode)
+	if err != nil {
+		return nil, err
 	}
 
 	queryParams := map[string]string{
@@ -274,7 +278,7 @@ func (s *StockMarket) getMarketMinFromEast(stockCode string) ([]types.MarketMin,
 		"ndays":   "1",
 		"iscr":    "1",
 		"iscca":   "0",
-		"secid":   fmt.Sprintf("%s.%s", secID, stockCode),
+		"secid":   sym.SecID(),
 		"_":       strconv.FormatInt(time.Now().UnixMilli(), 10),
 	}
 
@@ -285,7 +289,7 @@ func (s *StockMarket) getMarketMinFromEast(stockCode string) ([]types.MarketMin,
 		} `json:"data"`
 	}
 
-	err := s.client.GetJSON(baseURL, queryParams, headers.EastMoneyHeaders, &result)
+	err = s.client.GetJSON(baseURL, queryParams, headers.EastMoneyHeaders, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -410,15 +414,11 @@ func (s *StockMarket) getCurrentMarketFromSina(codes []string) ([]types.CurrentM
 			continue
 		}
 
-		prefix := code[:1]
-		switch prefix {
-		case "0", "3":
-			urlCodes = append(urlCodes, "s_sz"+code)
-		case "6", "9":
-			urlCodes = append(urlCodes, "s_sh"+code)
-		case "4", "8":
-			urlCodes = append(urlCodes, "s_bj"+code)
+		sym, err := symbol.Parse(code)
+		if err != nil {
+			continue
 		}
+		urlCodes = append(urlCodes, "s_"+sym.Prefixed())
 	}
 
 	if len(urlCodes) == 0 {
@@ -485,15 +485,11 @@ func (s *StockMarket) getCurrentMarketFromTencent(codes []string) ([]types.Curre
 			continue
 		}
 
-		prefix := code[:1]
-		switch prefix {
-		case "0", "3":
-			urlCodes = append(urlCodes, "s_sz"+code)
-		case "6", "9":
-			urlCodes = append(urlCodes, "s_sh"+code)
-		case "4", "8":
-			urlCodes = append(urlCodes, "s_bj"+code)
+		sym, err := symbol.Parse(code)
+		if err != nil {
+			continue
 		}
+		urlCodes = append(urlCodes, "s_"+sym.Prefixed())
 	}
 
 	if len(urlCodes) == 0 {
@@ -568,19 +564,12 @@ func (s *StockMarket) GetMarketFive(stockCode string) (*types.MarketFive, error)
 func (s *StockMarket) getFiveMarketFromTencent(stockCode string) (*types.MarketFive, error) {
 	baseURL := "https://web.sqt.gtimg.cn/q="
 
-	// 构建请求URL
-	var urlCode string
-	prefix := stockCode[:1]
-	switch prefix {
-	case "0", "3":
-		urlCode = "sz" + stockCode
-	case "6", "9":
-		urlCode = "sh" + stockCode
-	case "4", "8":
-		urlCode = "bj" + stockCode
+	sym, err := symbol.Parse(stockCode)
+	if err != nil {
+		return nil, err
 	}
 
-	url := baseURL + urlCode
+	url := baseURL + sym.Prefixed()
 
 	response, err := s.client.GetText(url, nil, nil)
 	if err != nil {
@@ -648,10 +637,9 @@ func (s *StockMarket) GetCapitalFlowMin(stockCode string) ([]types.CapitalFlow,
 func (s *StockMarket) getCapitalFlowMinFromEast(stockCode string) ([]types.CapitalFlow, error) {
 	baseURL := "https://push2.eastmoney.com/api/qt/stock/fflow/kline/get"
 
-	// 参数处理
-	secID := "0"
-	if strings.HasPrefix(stockCode, "6") {
-		secID = "1"
+	sym, err := symbol.Parse(stockCode)
+	if err != nil {
+		return nil, err
 	}
 
 	queryParams := map[string]string{
@@ -659,7 +647,7 @@ func (s *StockMarket) getCapitalFlowMinFromEast(stockCode string) ([]types.Capit
 		"klt":     "1",
 		"fields1": "f1,f2,f3,f7",
 		"fields2": "f51,f52,f53,f54,f55,f56,f57,f58,f59,f60,f61,f62,f63,f64,f65",
-		"secid":   fmt.Sprintf("%s.%s", secID, stockCode),
+		"secid":   sym.SecID(),
 	}
 
 	var result struct {
@@ -668,7 +656,7 @@ func (s *StockMarket) getCapitalFlowMinFromEast(stockCode string) ([]types.Capit
 		} `json:"data"`
 	}
 
-	err := s.client.GetJSON(baseURL, queryParams, headers.EastMoneyHeaders, &result)
+	err = s.client.GetJSON(baseURL, queryParams, headers.EastMoneyHeaders, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -726,10 +714,9 @@ func (s *StockMarket) GetCapitalFlow(stockCode, startDate, endDate string) ([]ty
 func (s *StockMarket) getCapitalFlowFromEast(stockCode, startDate, endDate string) ([]types.CapitalFlow, error) {
 	baseURL := "https://push2his.eastmoney.com/api/qt/stock/fflow/daykline/get"
 
-	// 参数处理
-	secID := "0"
-	if strings.HasPrefix(stockCode, "6") {
-		secID = "1"
+	sym, err := symbol.Parse(stockCode)
+	if err != nil {
+		return nil, err
 	}
 
 	queryParams := map[string]string{
@@ -737,7 +724,7 @@ func (s *StockMarket) getCapitalFlowFromEast(stockCode, startDate, endDate strin
 		"klt":     "101",
 		"fields1": "f1,f2,f3,f7",
 		"fields2": "f51,f52,f53,f54,f55,f56,f57,f58,f59,f60,f61",
-		"secid":   fmt.Sprintf("%s.%s", secID, stockCode),
+		"secid":   sym.SecID(),
 	}
 
 	var result struct {
@@ -746,7 +733,7 @@ func (s *StockMarket) getCapitalFlowFromEast(stockCode, startDate, endDate strin
 		} `json:"data"`
 	}
 
-	err := s.client.GetJSON(baseURL, queryParams, headers.EastMoneyHeaders, &result)
+	err = s.client.GetJSON(baseURL, queryParams, headers.EastMoneyHeaders, &result)
 	if err != nil {
 		return nil, err
 	}
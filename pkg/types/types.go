@@ -11,6 +11,63 @@ type StockCode struct {
 	ListDate  string `json:"list_date"`  // 上市日期
 }
 
+// DelistedStock 终止上市股票
+type DelistedStock struct {
+	StockCode  string `json:"stock_code"`  // 股票代码
+	ShortName  string `json:"short_name"`  // 股票简称
+	Exchange   string `json:"exchange"`    // 交易所
+	ListDate   string `json:"list_date"`   // 上市日期
+	DelistDate string `json:"delist_date"` // 终止上市日期
+}
+
+// NameChange 股票简称变更记录
+type NameChange struct {
+	StockCode     string `json:"stock_code"`     // 股票代码
+	Exchange      string `json:"exchange"`       // 交易所
+	EffectiveDate string `json:"effective_date"` // 变更生效日期
+	OldName       string `json:"old_name"`       // 变更前简称
+	NewName       string `json:"new_name"`       // 变更后简称
+	Status        string `json:"status"`         // 变更后状态：正常、ST、*ST、退市
+}
+
+// IPO 新股申购与上市信息
+type IPO struct {
+	StockCode        string  `json:"stock_code"`          // 股票代码
+	ShortName        string  `json:"short_name"`          // 股票简称
+	ApplyCode        string  `json:"apply_code"`          // 申购代码
+	ApplyDate        string  `json:"apply_date"`          // 申购日期
+	IssuePrice       float64 `json:"issue_price"`         // 发行价（元）
+	IssuePE          float64 `json:"issue_pe"`            // 发行市盈率
+	IndustryPE       float64 `json:"industry_pe"`         // 行业市盈率
+	LotWinningRate   float64 `json:"lot_winning_rate"`    // 网上中签率（%）
+	ListingDate      string  `json:"listing_date"`        // 上市日期，未上市时为空
+	FirstDayOpenPct  float64 `json:"first_day_open_pct"`  // 首日开盘涨幅（%）
+	FirstDayClosePct float64 `json:"first_day_close_pct"` // 首日收盘涨幅（%）
+	Exchange         string  `json:"exchange"`            // 交易所
+	Board            string  `json:"board"`               // 上市板块
+}
+
+// StockProfile 股票基本资料
+type StockProfile struct {
+	StockCode     string  `json:"stock_code"`     // 股票代码
+	ShortName     string  `json:"short_name"`     // 股票简称
+	FullName      string  `json:"full_name"`      // 公司全称
+	EnglishName   string  `json:"english_name"`   // 英文名称
+	Exchange      string  `json:"exchange"`       // 交易所
+	Board         string  `json:"board"`          // 上市板块：主板、创业板、科创板、北交所
+	Region        string  `json:"region"`         // 注册地区
+	Industry      string  `json:"industry"`       // 所属行业
+	ListDate      string  `json:"list_date"`      // 上市日期
+	IPOPrice      float64 `json:"ipo_price"`      // 发行价（元）
+	RegCapital    float64 `json:"reg_capital"`    // 注册资本（元）
+	Chairman      string  `json:"chairman"`       // 董事长
+	Website       string  `json:"website"`        // 公司网站
+	BusinessScope string  `json:"business_scope"` // 经营范围
+	Introduction  string  `json:"introduction"`   // 公司简介
+	Status        string  `json:"status"`         // 当前状态：正常、ST、*ST、退市
+	Suspended     bool    `json:"suspended"`      // 当日是否停牌，与 Status 相互独立
+}
+
 // IndexCode 指数代码信息
 type IndexCode struct {
 	IndexCode string `json:"index_code"` // 指数代码
@@ -18,6 +75,17 @@ type IndexCode struct {
 	Exchange  string `json:"exchange"`   // 交易所
 }
 
+// IndexConstituent 指数成分股及权重
+type IndexConstituent struct {
+	IndexCode    string  `json:"index_code"`    // 指数代码
+	SnapshotDate string  `json:"snapshot_date"` // 成分快照日期
+	StockCode    string  `json:"stock_code"`    // 股票代码
+	ShortName    string  `json:"short_name"`    // 股票简称
+	Industry     string  `json:"industry"`      // 所属行业
+	Weight       float64 `json:"weight"`        // 权重（%）
+	Source       string  `json:"source"`        // 数据来源
+}
+
 // ConceptCode 概念代码信息
 type ConceptCode struct {
 	ConceptCode string `json:"concept_code"` // 概念代码
@@ -60,6 +128,7 @@ type MarketData struct {
 type CurrentMarket struct {
 	StockCode  string  `json:"stock_code"`  // 股票代码
 	ShortName  string  `json:"short_name"`  // 股票简称
+	Exchange   string  `json:"exchange"`    // 交易所：SH、SZ、BJ，指数行情返回，用于区分同码指数与股票
 	Price      float64 `json:"price"`       // 当前价格
 	Change     float64 `json:"change"`      // 涨跌额
 	ChangePct  float64 `json:"change_pct"`  // 涨跌幅
@@ -123,6 +192,49 @@ type StockShares struct {
 	ChangeReason string  `json:"change_reason"` // 变更原因
 }
 
+// Dividend 分红送转方案，比例均已换算为每股
+type Dividend struct {
+	StockCode     string  `json:"stock_code"`     // 股票代码
+	ReportDate    string  `json:"report_date"`    // 报告期
+	NoticeDate    string  `json:"notice_date"`    // 预案公告日
+	CashPerShare  float64 `json:"cash_per_share"` // 每股派息（税前，元）
+	BonusRatio    float64 `json:"bonus_ratio"`    // 每股送股
+	TransferRatio float64 `json:"transfer_ratio"` // 每股转增
+	RecordDate    string  `json:"record_date"`    // 股权登记日
+	ExDate        string  `json:"ex_date"`        // 除权除息日
+	PayDate       string  `json:"pay_date"`       // 派息日
+	Progress      string  `json:"progress"`       // 方案进度，如 实施方案、董事会预案
+	Plan          string  `json:"plan"`           // 方案说明，如 10派3元
+}
+
+// RightsIssue 配股方案
+type RightsIssue struct {
+	StockCode  string  `json:"stock_code"`  // 股票代码
+	NoticeDate string  `json:"notice_date"` // 公告日期
+	Ratio      float64 `json:"ratio"`       // 每股配股数
+	Price      float64 `json:"price"`       // 配股价（元）
+	Shares     float64 `json:"shares"`      // 实际配股数量（股）
+	RecordDate string  `json:"record_date"` // 股权登记日
+	ExDate     string  `json:"ex_date"`     // 除权日
+}
+
+// CorporateAction 按除权除息日合并的公司行为，用于复权计算
+type CorporateAction struct {
+	StockCode     string  `json:"stock_code"`      // 股票代码
+	ExDate        string  `json:"ex_date"`         // 除权除息日
+	CashPerShare  float64 `json:"cash_per_share"`  // 每股派息（税前，元）
+	BonusPerShare float64 `json:"bonus_per_share"` // 每股送转股数
+	RightsRatio   float64 `json:"rights_ratio"`    // 每股配股数
+	RightsPrice   float64 `json:"rights_price"`    // 配股价（元）
+}
+
+// AdjustFactor 复权因子，为截至该交易日的累计后复权因子，以计算时传入的首根K线为1
+type AdjustFactor struct {
+	StockCode string  `json:"stock_code"` // 股票代码
+	TradeDate string  `json:"trade_date"` // 交易日期
+	Factor    float64 `json:"factor"`     // 累计复权因子
+}
+
 // IndustrySW 申万行业信息
 type IndustrySW struct {
 	StockCode    string `json:"stock_code"`    // 股票代码
@@ -192,7 +304,7 @@ type FinanceCore struct {
 	// 每股指标
 	BasicEPS       float64 `json:"basic_eps"`        // 基本每股收益
 	DilutedEPS     float64 `json:"diluted_eps"`      // 稀释每股收益
-	NonGaapEPS     float64 `json:"non_gaap_eps"`     // 非GAAP每股收益
+	NonGaapEPS     float64 `json:"non_gaap_eps"`     // 扣除非经常性损益后的基本每股收益
 	NetAssetPS     float64 `json:"net_asset_ps"`     // 每股净资产
 	CapReservePS   float64 `json:"cap_reserve_ps"`   // 每股资本公积
 	UndistProfitPS float64 `json:"undist_profit_ps"` // 每股未分配利润
@@ -368,3 +480,250 @@ type Profit struct {
 	BasicEPS   float64 `json:"basic_eps"`   // 基本每股收益
 	DilutedEPS float64 `json:"diluted_eps"` // 稀释每股收益
 }
+
+// FinanceRatio 根据三大报表计算的财务比率，比率均为小数，流量类指标使用滚动十二个月（TTM）数据
+type FinanceRatio struct {
+	StockCode  string `json:"stock_code"`  // 股票代码
+	ReportDate string `json:"report_date"` // 报告期
+
+	// 盈利能力（杜邦分解：ROE = 净利率 × 总资产周转率 × 权益乘数）
+	ROE              float64 `json:"roe"`               // 净资产收益率
+	NetMargin        float64 `json:"net_margin"`        // 净利率
+	AssetTurnover    float64 `json:"asset_turnover"`    // 总资产周转率
+	EquityMultiplier float64 `json:"equity_multiplier"` // 权益乘数
+	ROIC             float64 `json:"roic"`              // 投入资本回报率
+	GrossMargin      float64 `json:"gross_margin"`      // 毛利率
+
+	// 偿债能力
+	CurrentRatio     float64 `json:"current_ratio"`     // 流动比率
+	QuickRatio       float64 `json:"quick_ratio"`       // 速动比率
+	InterestCoverage float64 `json:"interest_coverage"` // 利息保障倍数
+
+	// 现金流与盈利质量
+	FCF          float64 `json:"fcf"`           // 自由现金流
+	AccrualRatio float64 `json:"accrual_ratio"` // 应计比率
+
+	// 成长能力
+	RevenueGrowth   float64 `json:"revenue_growth"`    // 营业总收入同比增长率
+	NetProfitGrowth float64 `json:"net_profit_growth"` // 归母净利润同比增长率
+
+	// 每股指标
+	TotalShares float64 `json:"total_shares"` // 总股本
+	EPS         float64 `json:"eps"`          // 每股收益
+	BPS         float64 `json:"bps"`          // 每股净资产
+	FCFPS       float64 `json:"fcfps"`        // 每股自由现金流
+}
+
+// EarningsForecast 业绩预告
+type EarningsForecast struct {
+	StockCode     string  `json:"stock_code"`     // 股票代码
+	ShortName     string  `json:"short_name"`     // 股票简称
+	ReportDate    string  `json:"report_date"`    // 报告期
+	NoticeDate    string  `json:"notice_date"`    // 公告日期
+	Indicator     string  `json:"indicator"`      // 预测指标，如 归属于上市公司股东的净利润
+	ForecastType  string  `json:"forecast_type"`  // 预告类型：预增、预减、扭亏、首亏、续亏、续盈、略增、略减、不确定
+	ValueLower    float64 `json:"value_lower"`    // 预测数值下限
+	ValueUpper    float64 `json:"value_upper"`    // 预测数值上限
+	ChangeLower   float64 `json:"change_lower"`   // 同比变动幅度下限（%）
+	ChangeUpper   float64 `json:"change_upper"`   // 同比变动幅度上限（%）
+	PreviousValue float64 `json:"previous_value"` // 上年同期值
+	Content       string  `json:"content"`        // 预告内容
+	ChangeReason  string  `json:"change_reason"`  // 业绩变动原因
+}
+
+// EarningsExpress 业绩快报
+type EarningsExpress struct {
+	StockCode         string  `json:"stock_code"`           // 股票代码
+	ShortName         string  `json:"short_name"`           // 股票简称
+	ReportDate        string  `json:"report_date"`          // 报告期
+	NoticeDate        string  `json:"notice_date"`          // 公告日期
+	Revenue           float64 `json:"revenue"`              // 营业总收入
+	RevenueLastYear   float64 `json:"revenue_last_year"`    // 上年同期营业总收入
+	RevenueYoY        float64 `json:"revenue_yoy"`          // 营业总收入同比增长（%）
+	NetProfit         float64 `json:"net_profit"`           // 归母净利润
+	NetProfitLastYear float64 `json:"net_profit_last_year"` // 上年同期归母净利润
+	NetProfitYoY      float64 `json:"net_profit_yoy"`       // 归母净利润同比增长（%）
+	EPS               float64 `json:"eps"`                  // 每股收益
+	BPS               float64 `json:"bps"`                  // 每股净资产
+	ROE               float64 `json:"roe"`                  // 加权平均净资产收益率（%）
+}
+
+// MainBusiness 主营构成
+type MainBusiness struct {
+	StockCode    string  `json:"stock_code"`    // 股票代码
+	ReportDate   string  `json:"report_date"`   // 报告期
+	Category     string  `json:"category"`      // 分类方式：按行业、按产品、按地区
+	ItemName     string  `json:"item_name"`     // 构成项目
+	Revenue      float64 `json:"revenue"`       // 主营收入
+	RevenueRatio float64 `json:"revenue_ratio"` // 收入占比（小数）
+	Cost         float64 `json:"cost"`          // 主营成本
+	CostRatio    float64 `json:"cost_ratio"`    // 成本占比（小数）
+	Profit       float64 `json:"profit"`        // 主营利润
+	ProfitRatio  float64 `json:"profit_ratio"`  // 利润占比（小数）
+	GrossMargin  float64 `json:"gross_margin"`  // 毛利率（小数）
+	Rank         int     `json:"rank"`          // 同一分类内的排序
+}
+
+// Valuation 每日估值
+type Valuation struct {
+	StockCode      string  `json:"stock_code"`       // 股票代码
+	TradeDate      string  `json:"trade_date"`       // 交易日期
+	Close          float64 `json:"close"`            // 收盘价
+	PETTM          float64 `json:"pe_ttm"`           // 市盈率（TTM）
+	PELYR          float64 `json:"pe_lyr"`           // 市盈率（静态）
+	PBMRQ          float64 `json:"pb_mrq"`           // 市净率（MRQ）
+	PSTTM          float64 `json:"ps_ttm"`           // 市销率（TTM）
+	DividendYield  float64 `json:"dividend_yield"`   // 股息率（近12个月，小数）
+	TotalMarketCap float64 `json:"total_market_cap"` // 总市值
+	FloatMarketCap float64 `json:"float_market_cap"` // 流通市值
+}
+
+// DragonTiger 龙虎榜上榜记录
+type DragonTiger struct {
+	StockCode   string  `json:"stock_code"`    // 股票代码
+	ShortName   string  `json:"short_name"`    // 股票简称
+	TradeDate   string  `json:"trade_date"`    // 交易日期
+	Reason      string  `json:"reason"`        // 上榜原因
+	Close       float64 `json:"close"`         // 收盘价
+	ChangePct   float64 `json:"change_pct"`    // 涨跌幅
+	NetBuy      float64 `json:"net_buy"`       // 龙虎榜净买额
+	BuyAmount   float64 `json:"buy_amount"`    // 龙虎榜买入额
+	SellAmount  float64 `json:"sell_amount"`   // 龙虎榜卖出额
+	DealAmount  float64 `json:"deal_amount"`   // 龙虎榜成交额
+	TotalAmount float64 `json:"total_amount"`  // 市场总成交额
+	NetBuyRatio float64 `json:"net_buy_ratio"` // 净买额占总成交比
+	DealRatio   float64 `json:"deal_ratio"`    // 成交额占总成交比
+	Turnover    float64 `json:"turnover"`      // 换手率
+	CircMarket  float64 `json:"circ_market"`   // 流通市值
+}
+
+// DragonTigerSeat 龙虎榜营业部席位明细
+type DragonTigerSeat struct {
+	StockCode     string  `json:"stock_code"`     // 股票代码
+	TradeDate     string  `json:"trade_date"`     // 交易日期
+	Side          string  `json:"side"`           // 方向：buy-买入前五，sell-卖出前五
+	Rank          int     `json:"rank"`           // 排名
+	BranchCode    string  `json:"branch_code"`    // 营业部代码
+	BranchName    string  `json:"branch_name"`    // 营业部名称
+	BuyAmount     float64 `json:"buy_amount"`     // 买入额
+	SellAmount    float64 `json:"sell_amount"`    // 卖出额
+	NetAmount     float64 `json:"net_amount"`     // 净额
+	BuyRatio      float64 `json:"buy_ratio"`      // 买入额占总成交比
+	SellRatio     float64 `json:"sell_ratio"`     // 卖出额占总成交比
+	IsInstitution bool    `json:"is_institution"` // 是否机构专用席位
+	Reason        string  `json:"reason"`         // 上榜原因
+}
+
+// DragonTigerBranchTrade 营业部龙虎榜历史交易
+type DragonTigerBranchTrade struct {
+	BranchCode string  `json:"branch_code"` // 营业部代码
+	BranchName string  `json:"branch_name"` // 营业部名称
+	TradeDate  string  `json:"trade_date"`  // 交易日期
+	StockCode  string  `json:"stock_code"`  // 股票代码
+	ShortName  string  `json:"short_name"`  // 股票简称
+	BuyAmount  float64 `json:"buy_amount"`  // 买入额
+	SellAmount float64 `json:"sell_amount"` // 卖出额
+	NetAmount  float64 `json:"net_amount"`  // 净额
+	ChangePct  float64 `json:"change_pct"`  // 当日涨跌幅
+	Reason     string  `json:"reason"`      // 上榜原因
+}
+
+// LimitPool 涨停、跌停、炸板股池条目
+type LimitPool struct {
+	StockCode      string  `json:"stock_code"`       // 股票代码
+	ShortName      string  `json:"short_name"`       // 股票简称
+	TradeDate      string  `json:"trade_date"`       // 交易日期
+	PoolType       string  `json:"pool_type"`        // 股池类型：up-涨停，down-跌停，broken-炸板
+	Price          float64 `json:"price"`            // 最新价
+	ChangePct      float64 `json:"change_pct"`       // 涨跌幅
+	LimitPrice     float64 `json:"limit_price"`      // 涨停价或跌停价
+	LimitRatio     float64 `json:"limit_ratio"`      // 涨跌幅限制比例，如0.1、0.2、0.3、0.05
+	Amount         float64 `json:"amount"`           // 成交额
+	CircMarket     float64 `json:"circ_market"`      // 流通市值
+	Turnover       float64 `json:"turnover"`         // 换手率
+	FirstLimitTime string  `json:"first_limit_time"` // 首次封板时间，如 09:30:00
+	LastLimitTime  string  `json:"last_limit_time"`  // 最后封板时间
+	SealAmount     float64 `json:"seal_amount"`      // 封单金额
+	OpenTimes      int     `json:"open_times"`       // 开板（炸板）次数
+	Streak         int     `json:"streak"`           // 连板数或连续跌停天数
+	Industry       string  `json:"industry"`         // 所属行业
+}
+
+// LimitStreakStat 连板高度统计
+type LimitStreakStat struct {
+	TradeDate  string   `json:"trade_date"`  // 交易日期
+	Streak     int      `json:"streak"`      // 连板数
+	Count      int      `json:"count"`       // 股票数量
+	StockCodes []string `json:"stock_codes"` // 股票代码列表
+}
+
+// MarketBreadth 全市场涨跌分布
+type MarketBreadth struct {
+	TradeDate        string             `json:"trade_date"`         // 交易日期
+	Total            int                `json:"total"`              // 参与统计的股票数（不含停牌）
+	Advancers        int                `json:"advancers"`          // 上涨家数
+	Decliners        int                `json:"decliners"`          // 下跌家数
+	Unchanged        int                `json:"unchanged"`          // 平盘家数
+	LimitUp          int                `json:"limit_up"`           // 涨停家数
+	LimitDown        int                `json:"limit_down"`         // 跌停家数
+	TotalAmount      float64            `json:"total_amount"`       // 总成交额
+	AmountByExchange map[string]float64 `json:"amount_by_exchange"` // 各交易所成交额，键为 SH/SZ/BJ
+	ChangeBuckets    []ChangeBucket     `json:"change_buckets"`     // 涨跌幅分布
+}
+
+// ChangeBucket 涨跌幅区间统计
+type ChangeBucket struct {
+	Label string `json:"label"` // 区间名称，如 "3%~5%"
+	Count int    `json:"count"` // 股票数量
+}
+
+// BlockTrade 大宗交易记录
+type BlockTrade struct {
+	StockCode    string  `json:"stock_code"`    // 股票代码
+	ShortName    string  `json:"short_name"`    // 股票简称
+	TradeDate    string  `json:"trade_date"`    // 交易日期
+	Price        float64 `json:"price"`         // 成交价
+	Close        float64 `json:"close"`         // 当日收盘价
+	PremiumRatio float64 `json:"premium_ratio"` // 成交价相对收盘价溢价率，负值为折价
+	Volume       float64 `json:"volume"`        // 成交量（股）
+	Amount       float64 `json:"amount"`        // 成交额
+	BuyerBranch  string  `json:"buyer_branch"`  // 买方营业部
+	SellerBranch string  `json:"seller_branch"` // 卖方营业部
+	ChangePct    float64 `json:"change_pct"`    // 当日涨跌幅
+}
+
+// ShareholderCount 股东户数
+type ShareholderCount struct {
+	StockCode          string  `json:"stock_code"`            // 股票代码
+	EndDate            string  `json:"end_date"`              // 统计截止日期
+	HolderNum          int64   `json:"holder_num"`            // 股东户数
+	HolderNumChangePct float64 `json:"holder_num_change_pct"` // 股东户数较上期变化比例
+	AvgShares          float64 `json:"avg_shares"`            // 户均持股数量
+	AvgSharesChangePct float64 `json:"avg_shares_change_pct"` // 户均持股数量较上期变化比例
+	AvgHoldAmount      float64 `json:"avg_hold_amount"`       // 户均持股市值
+	Top10Ratio         float64 `json:"top10_ratio"`           // 十大股东持股合计比例
+	Top10FloatRatio    float64 `json:"top10_float_ratio"`     // 十大流通股东持股合计比例
+}
+
+// TopHolder 十大股东或十大流通股东
+type TopHolder struct {
+	StockCode  string  `json:"stock_code"`  // 股票代码
+	EndDate    string  `json:"end_date"`    // 报告期
+	IsFloat    bool    `json:"is_float"`    // 是否为十大流通股东
+	Rank       int     `json:"rank"`        // 名次
+	HolderName string  `json:"holder_name"` // 股东名称
+	HolderType string  `json:"holder_type"` // 股东性质，如个人、基金、QFII
+	ShareType  string  `json:"share_type"`  // 股份类型，如流通A股、限售流通A股
+	Shares     float64 `json:"shares"`      // 持股数量
+	Ratio      float64 `json:"ratio"`       // 持股比例
+	Change     float64 `json:"change"`      // 较上期持股变动数量
+	ChangeType string  `json:"change_type"` // 变动类型：新进、增加、减少、不变
+}
+
+// ConceptConstituent 概念或行业板块成分股
+type ConceptConstituent struct {
+	ConceptCode string `json:"concept_code"` // 板块代码
+	StockCode   string `json:"stock_code"`   // 股票代码
+	ShortName   string `json:"short_name"`   // 股票简称
+	Source      string `json:"source"`       // 数据来源
+}
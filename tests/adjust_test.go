@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"testing"
+
+	adataErrors "github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/stock/adjust"
+	"github.com/onepiecelover/adata-go/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func adjustTestBars() []types.MarketData {
+	return []types.MarketData{
+		{StockCode: "600000", TradeDate: "2024-06-05", Open: 6.6, High: 6.7, Low: 6.5, Close: 6.6},
+		{StockCode: "600000", TradeDate: "2024-06-03", Open: 9.9, High: 10.1, Low: 9.8, Close: 10},
+		{StockCode: "600000", TradeDate: "2024-06-04", Open: 6.4, High: 6.6, Low: 6.3, Close: 6.5},
+	}
+}
+
+func TestAdjustFactors(t *testing.T) {
+	// 10送5派5元：参考价 = (10 - 0.5) / 1.5 = 6.3333，因子 = 10 / 6.3333 = 1.578947
+	actions := []types.CorporateAction{
+		{StockCode: "600000", ExDate: "2024-06-04", CashPerShare: 0.5, BonusPerShare: 0.5},
+		{StockCode: "600000", ExDate: "2024-01-02", CashPerShare: 0.3}, // 早于首根K线，不参与计算
+	}
+
+	factors, err := adjust.Factors(adjustTestBars(), actions)
+	assert.NoError(t, err)
+	assert.Len(t, factors, 3)
+
+	assert.Equal(t, "2024-06-03", factors[0].TradeDate)
+	assert.Equal(t, 1.0, factors[0].Factor)
+	assert.InDelta(t, 1.578947, factors[1].Factor, 1e-6)
+	assert.InDelta(t, 1.578947, factors[2].Factor, 1e-6)
+}
+
+func TestAdjustFactors_RightsIssue(t *testing.T) {
+	// 10配3，配股价8元：参考价 = (10 + 8 × 0.3) / 1.3 = 9.538462，因子 = 1.048387
+	actions := []types.CorporateAction{
+		{StockCode: "600000", ExDate: "2024-06-04", RightsRatio: 0.3, RightsPrice: 8},
+	}
+
+	factors, err := adjust.Factors(adjustTestBars(), actions)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.048387, factors[1].Factor, 1e-6)
+}
+
+func TestAdjust(t *testing.T) {
+	bars := adjustTestBars()
+	actions := []types.CorporateAction{
+		{StockCode: "600000", ExDate: "2024-06-04", CashPerShare: 0.5, BonusPerShare: 0.5},
+	}
+
+	factors, err := adjust.Factors(bars, actions)
+	assert.NoError(t, err)
+
+	backward, err := adjust.Adjust(bars, factors, adjust.Backward)
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, backward[0].Close)
+	assert.InDelta(t, 10.263158, backward[1].Close, 1e-6)
+	assert.InDelta(t, 10.421053, backward[2].Close, 1e-6)
+
+	forward, err := adjust.Adjust(bars, factors, adjust.Forward)
+	assert.NoError(t, err)
+	assert.InDelta(t, 6.333333, forward[0].Close, 1e-6)
+	assert.InDelta(t, 6.27, forward[0].Open, 1e-6)
+	assert.Equal(t, 6.5, forward[1].Close)
+	assert.Equal(t, 6.6, forward[2].Close)
+
+	// 以除权前的日期为基准时，除权前价格保持不变
+	anchored, err := adjust.AdjustAt(bars, factors, "2024-06-03")
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, anchored[0].Close)
+	assert.InDelta(t, 10.263158, anchored[1].Close, 1e-6)
+
+	raw, err := adjust.Adjust(bars, factors, adjust.None)
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, raw[0].Close)
+
+	_, err = adjust.Adjust(bars, factors, 9)
+	if assert.Error(t, err, "Should return error for unsupported adjust type") {
+		assert.Equal(t, adataErrors.ErrInvalidParameter.Code, err.(*adataErrors.ADataError).Code)
+	}
+
+	_, err = adjust.Adjust(bars, factors[:1], adjust.Backward)
+	assert.Error(t, err, "Should return error when factors are missing")
+}
+
+func TestAdjustFactors_InvalidReferencePrice(t *testing.T) {
+	actions := []types.CorporateAction{
+		{StockCode: "600000", ExDate: "2024-06-04", CashPerShare: 20},
+	}
+
+	_, err := adjust.Factors(adjustTestBars(), actions)
+	assert.Error(t, err, "Should return error when cash dividend exceeds previous close")
+}
@@ -0,0 +1,260 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onepiecelover/adata-go/pkg/calendar"
+	"github.com/onepiecelover/adata-go/pkg/common/client"
+	"github.com/onepiecelover/adata-go/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendar_Snapshot(t *testing.T) {
+	cal, err := calendar.New()
+	assert.NoError(t, err, "内置快照应能正常解析")
+
+	// 快照覆盖的年份应连续
+	years := cal.Years()
+	assert.NotEmpty(t, years)
+	for i := 1; i < len(years); i++ {
+		assert.Equal(t, years[i-1]+1, years[i])
+	}
+	assert.Equal(t, 2015, years[0])
+}
+
+func TestCalendar_IsTradingDay(t *testing.T) {
+	cal := newTestCalendar(t)
+
+	cases := map[string]bool{
+		"2024-10-08": true,  // 国庆节后首个交易日
+		"2024-10-07": false, // 国庆节休市
+		"2024-10-12": false, // 调休周六不交易
+		"20250102":   true,
+		"2025-01-29": false, // 春节
+	}
+	for date, expected := range cases {
+		ok, err := cal.IsTradingDay(date)
+		assert.NoError(t, err, date)
+		assert.Equal(t, expected, ok, date)
+	}
+
+	_, err := cal.IsTradingDay("1990-12-19")
+	assert.Error(t, err)
+
+	_, err = cal.IsTradingDay("invalid")
+	assert.Error(t, err)
+}
+
+func TestCalendar_Navigation(t *testing.T) {
+	cal := newTestCalendar(t)
+
+	next, err := cal.NextTradingDay("2024-09-30")
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-10-08", next)
+
+	prev, err := cal.PrevTradingDay("2024-10-08")
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-09-30", prev)
+
+	// 跨年
+	next, err = cal.NextTradingDay("2023-12-29")
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-02", next)
+
+	nth, err := cal.NthTradingDay("2024-09-27", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-10-08", nth)
+
+	nth, err = cal.NthTradingDay("2024-10-08", -3)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-09-26", nth)
+
+	nth, err = cal.NthTradingDay("2024-10-05", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-10-08", nth)
+
+	days, err := cal.TradingDaysBetween("2024-09-27", "2024-10-09")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2024-09-27", "2024-09-30", "2024-10-08", "2024-10-09"}, days)
+
+	last, err := cal.LastTradingDayOfMonth(2024, time.September)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-09-30", last)
+
+	last, err = cal.LastTradingDayOfWeek("2024-04-02")
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-04-03", last)
+
+	// 2020 年春节整周休市
+	_, err = cal.LastTradingDayOfWeek("2020-01-29")
+	assert.Error(t, err)
+
+	// 超出覆盖范围时明确报错
+	_, err = cal.NextTradingDay("2099-12-31")
+	assert.Error(t, err)
+}
+
+func TestCalendar_Load(t *testing.T) {
+	cal := newTestCalendar(t)
+
+	// 2024 年全年交易日
+	days, err := cal.Days(2024)
+	assert.NoError(t, err)
+	assert.Len(t, days, 366)
+
+	open := 0
+	for _, day := range days {
+		open += day.TradeStatus
+	}
+	assert.Equal(t, 242, open)
+
+	// 覆盖 2030 年，仅 1 月 2 日休市
+	var year []types.TradeCalendar
+	for d := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC); d.Year() == 2030; d = d.AddDate(0, 0, 1) {
+		status := 1
+		if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday || d.Format("2006-01-02") == "2030-01-02" {
+			status = 0
+		}
+		year = append(year, types.TradeCalendar{TradeDate: d.Format("2006-01-02"), TradeStatus: status})
+	}
+
+	// 不完整的年份被拒绝
+	assert.Error(t, cal.Load(year[:100]))
+	_, err = cal.IsTradingDay("2030-01-03")
+	assert.Error(t, err)
+
+	assert.NoError(t, cal.Load(year))
+	ok, err := cal.IsTradingDay("2030-01-02")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	next, err := cal.NextTradingDay("2030-01-01")
+	assert.NoError(t, err)
+	assert.Equal(t, "2030-01-03", next)
+	assert.Contains(t, cal.Years(), 2030)
+}
+
+func TestFetchSSE(t *testing.T) {
+	c := client.NewClient()
+
+	// 未结束的年份无法由日K线确认全年交易日
+	_, err := calendar.FetchSSE(c, time.Now().Year()+1)
+	assert.Error(t, err)
+
+	days, err := calendar.FetchSSE(c, 2023)
+	if err != nil {
+		t.Logf("Network request failed (expected in some environments): %v", err)
+		return
+	}
+
+	expected, err := newTestCalendar(t).Days(2023)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, days, "上交所数据应与内置快照一致")
+}
+
+func TestCalendar_RefreshUpcoming(t *testing.T) {
+	cal := newTestCalendar(t)
+
+	if err := cal.RefreshUpcoming(); err != nil {
+		t.Logf("Network request failed (expected in some environments): %v", err)
+		return
+	}
+
+	// 当年必定覆盖；次年仅在休市安排公布后覆盖，覆盖时须包含工作日休市
+	year := time.Now().In(calendar.Shanghai).Year()
+	assert.Contains(t, cal.Years(), year)
+
+	days, err := cal.Days(year + 1)
+	if err != nil {
+		return
+	}
+	closed := 0
+	for _, day := range days {
+		d, _ := time.Parse("2006-01-02", day.TradeDate)
+		if day.TradeStatus == 0 && d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
+			closed++
+		}
+	}
+	assert.Positive(t, closed, "次年日历应包含工作日休市")
+}
+
+func newTestCalendar(t *testing.T) *calendar.Calendar {
+	cal, err := calendar.New()
+	if err != nil {
+		t.Fatalf("内置交易日历加载失败: %v", err)
+	}
+	return cal
+}
+
+func fixedClock(t *testing.T, value string) *calendar.Clock {
+	clock, err := calendar.NewClock(nil)
+	if err != nil {
+		t.Fatalf("市场时钟创建失败: %v", err)
+	}
+	now, _ := time.ParseInLocation("2006-01-02 15:04", value, calendar.Shanghai)
+	clock.SetNow(func() time.Time { return now })
+	return clock
+}
+
+func TestClock_Phase(t *testing.T) {
+	cases := []struct {
+		now       string
+		stockCode string
+		expected  calendar.Phase
+	}{
+		{"2024-10-08 09:10", "", calendar.PhaseClosed},
+		{"2024-10-08 09:15", "", calendar.PhasePreOpenAuction},
+		{"2024-10-08 09:24", "", calendar.PhasePreOpenAuction},
+		{"2024-10-08 09:25", "", calendar.PhasePreOpenBreak},
+		{"2024-10-08 09:27", "", calendar.PhasePreOpenBreak},
+		{"2024-10-08 09:30", "", calendar.PhaseContinuous},
+		{"2024-10-08 11:30", "", calendar.PhaseLunchBreak},
+		{"2024-10-08 13:00", "", calendar.PhaseContinuous},
+		{"2024-10-08 14:57", "", calendar.PhaseClosingAuction},
+		{"2024-10-08 15:10", "600000", calendar.PhaseClosed},
+		{"2024-10-08 15:10", "688981", calendar.PhaseAfterHours},
+		{"2024-10-08 15:10", "300750", calendar.PhaseAfterHours},
+		{"2024-10-08 15:30", "300750", calendar.PhaseClosed},
+		{"2024-10-07 10:00", "", calendar.PhaseClosed}, // 国庆节休市
+	}
+
+	for _, c := range cases {
+		phase, err := fixedClock(t, c.now).Phase(c.stockCode)
+		assert.NoError(t, err, c.now)
+		assert.Equal(t, c.expected, phase, c.now+" "+c.stockCode)
+	}
+
+	// 与主机时区无关：UTC 01:45 即北京时间 09:45
+	clock, err := calendar.NewClock(nil)
+	assert.NoError(t, err)
+	clock.SetNow(func() time.Time { return time.Date(2024, 10, 8, 1, 45, 0, 0, time.UTC) })
+	trading, err := clock.IsTrading()
+	assert.NoError(t, err)
+	assert.True(t, trading)
+
+	trading, err = fixedClock(t, "2024-10-08 09:27").IsTrading()
+	assert.NoError(t, err)
+	assert.False(t, trading)
+}
+
+func TestClock_NextOpenClose(t *testing.T) {
+	clock := fixedClock(t, "2024-09-30 14:00")
+
+	next, err := clock.NextOpen()
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-10-08 09:30", next.Format("2006-01-02 15:04"))
+
+	until, err := clock.UntilClose()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, until)
+
+	clock = fixedClock(t, "2024-10-08 12:00")
+	until, err = clock.UntilOpen()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, until)
+
+	next, err = clock.NextClose()
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-10-08 15:00", next.Format("2006-01-02 15:04"))
+}
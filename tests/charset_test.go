@@ -0,0 +1,33 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/onepiecelover/adata-go/pkg/common/charset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeGBK(t *testing.T) {
+	tests := []struct {
+		input    []byte
+		expected string
+	}{
+		{[]byte{0xD6, 0xD0, 0xCE, 0xC4}, "中文"},
+		{[]byte("abc123"), "abc123"},
+		{[]byte{0xC6, 0xBD, 0xB0, 0xB2, 0xD2, 0xF8, 0xD0, 0xD0, '(', '0', '0', '0', '0', '0', '1', ')'}, "平安银行(000001)"},
+		{[]byte{0x80}, "€"},
+		{[]byte{0xD6}, "�"}, // 截断的双字节编码
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, charset.DecodeGBK(test.input))
+	}
+}
+
+func TestDecodeAuto(t *testing.T) {
+	gbk := []byte{0xD6, 0xD0, 0xCE, 0xC4}
+
+	assert.Equal(t, "中文", charset.DecodeAuto(gbk, "text/html; charset=GBK"))
+	assert.Equal(t, "中文", charset.DecodeAuto(gbk, ""), "非法UTF-8内容应按GBK解码")
+	assert.Equal(t, "中文", charset.DecodeAuto([]byte("中文"), "text/html; charset=utf-8"))
+}
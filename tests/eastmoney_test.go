@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/onepiecelover/adata-go/pkg/common/client"
+	"github.com/onepiecelover/adata-go/pkg/common/eastmoney"
+	"github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// datacenterServer 模拟数据中心接口，按页码返回 handler 生成的响应体
+func datacenterServer(handler func(page string) string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, handler(r.URL.Query().Get("pageNumber")))
+	}))
+}
+
+func TestFetchAll_Pages(t *testing.T) {
+	server := datacenterServer(func(page string) string {
+		return fmt.Sprintf(`{"success":true,"code":0,"result":{"pages":2,"count":2,"data":[{"ID":"%s"}]}}`, page)
+	})
+	defer server.Close()
+
+	rows, err := eastmoney.FetchAll[struct {
+		ID string `json:"ID"`
+	}](client.NewClient(), eastmoney.Query{URL: server.URL, ReportName: "RPT_TEST"})
+	assert.NoError(t, err)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, "2", rows[1].ID)
+}
+
+func TestFetchAll_ExceedMaxPages(t *testing.T) {
+	server := datacenterServer(func(page string) string {
+		return `{"success":true,"code":0,"result":{"pages":3,"count":3,"data":[{}]}}`
+	})
+	defer server.Close()
+
+	_, err := eastmoney.FetchAll[struct{}](client.NewClient(), eastmoney.Query{URL: server.URL, ReportName: "RPT_TEST", MaxPages: 2})
+	assert.Error(t, err, "Should not silently truncate results")
+}
+
+func TestFetchPage_Failure(t *testing.T) {
+	empty := datacenterServer(func(page string) string {
+		return `{"success":false,"code":9201,"message":"返回数据为空","result":null}`
+	})
+	defer empty.Close()
+
+	_, err := eastmoney.FetchPage(client.NewClient(), eastmoney.Query{URL: empty.URL}, 1)
+	adataErr, ok := err.(*errors.ADataError)
+	assert.True(t, ok)
+	assert.Equal(t, errors.ErrNoDataFound.Code, adataErr.Code)
+
+	failed := datacenterServer(func(page string) string {
+		return `{"success":false,"code":9501,"message":"报表不存在","result":null}`
+	})
+	defer failed.Close()
+
+	_, err = eastmoney.FetchPage(client.NewClient(), eastmoney.Query{URL: failed.URL}, 1)
+	adataErr, ok = err.(*errors.ADataError)
+	assert.True(t, ok)
+	assert.Equal(t, errors.ErrRequestFailed.Code, adataErr.Code)
+}
+
+func TestFetchPage_SourceClient(t *testing.T) {
+	var source, clientName string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		source, clientName = r.URL.Query().Get("source"), r.URL.Query().Get("client")
+		fmt.Fprint(w, `{"success":true,"code":0,"result":{"pages":1,"count":0,"data":[]}}`)
+	}))
+	defer server.Close()
+
+	_, err := eastmoney.FetchPage(client.NewClient(), eastmoney.Query{URL: server.URL}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "WEB", source)
+	assert.Equal(t, "WEB", clientName)
+
+	_, err = eastmoney.FetchPage(client.NewClient(), eastmoney.Query{URL: server.URL, Source: "HSF10", Client: "PC"}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "HSF10", source)
+	assert.Equal(t, "PC", clientName)
+}
@@ -48,8 +48,17 @@ func TestPredefinedErrors(t *testing.T) {
 	// 测试预定义错误
 	assert.Equal(t, 10001, adataErrors.ErrInvalidStockCode.Code)
 	assert.Equal(t, 10002, adataErrors.ErrInvalidDateFormat.Code)
+	assert.Equal(t, 10003, adataErrors.ErrInvalidParameter.Code)
 	assert.Equal(t, 20001, adataErrors.ErrRequestFailed.Code)
 	assert.Equal(t, 20002, adataErrors.ErrParseResponseFailed.Code)
 	assert.Equal(t, 30001, adataErrors.ErrNoDataFound.Code)
 	assert.Equal(t, 30002, adataErrors.ErrDataSourceUnavailable.Code)
 }
+
+func TestIsNoDataFound(t *testing.T) {
+	assert.True(t, adataErrors.IsNoDataFound(adataErrors.ErrNoDataFound))
+	assert.True(t, adataErrors.IsNoDataFound(adataErrors.NewADataError(adataErrors.ErrNoDataFound.Code, "未找到行情数据", "")))
+	assert.False(t, adataErrors.IsNoDataFound(adataErrors.ErrRequestFailed))
+	assert.False(t, adataErrors.IsNoDataFound(errors.New("未找到数据")))
+	assert.False(t, adataErrors.IsNoDataFound(nil))
+}
@@ -0,0 +1,150 @@
+package tests
+
+import (
+	"math"
+	"testing"
+
+	"github.com/onepiecelover/adata-go/pkg/indicator"
+	"github.com/onepiecelover/adata-go/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// indicatorTestBars 20根K线，最高价 = 收盘价 + 0.3，最低价 = 收盘价 - 0.25
+// 参考值由按通达信公式另行编写的 Python 脚本计算（不依赖本包代码），保留10位小数，
+// 能化为分数的直接写出分数
+func indicatorTestBars() []types.MarketData {
+	closes := []float64{10.0, 10.2, 10.1, 10.5, 10.8, 10.6, 10.9, 11.2, 11.0, 10.7, 10.9, 11.3, 11.6, 11.4, 11.8, 12.0, 11.7, 11.5, 11.9, 12.3}
+
+	bars := make([]types.MarketData, len(closes))
+	for i, c := range closes {
+		bars[i] = types.MarketData{
+			High:   c + 0.3,
+			Low:    c - 0.25,
+			Close:  c,
+			Volume: int64(1000 + (i*37)%200),
+		}
+	}
+	return bars
+}
+
+// lastIndicatorValue 返回指标序列的最后一个值
+func lastIndicatorValue(values []float64) float64 {
+	return values[len(values)-1]
+}
+
+func TestIndicator_Averages(t *testing.T) {
+	closes := indicator.Closes(indicatorTestBars())
+
+	ma := indicator.MA(closes, 5)
+	assert.True(t, math.IsNaN(ma[3]))
+	assert.InDelta(t, 10.32, ma[4], 1e-9)
+	assert.InDelta(t, 11.88, lastIndicatorValue(ma), 1e-9)
+
+	assert.InDelta(t, 11.5536282175, lastIndicatorValue(indicator.EMA(closes, 12)), 1e-9)
+	assert.InDelta(t, 11.9007589249, lastIndicatorValue(indicator.SMA(closes, 3, 1)), 1e-9)
+	assert.InDelta(t, 325.0/89, lastIndicatorValue(indicator.BIAS(closes, 6)), 1e-9)
+
+	boll := indicator.BOLL(closes, 20, 2)
+	assert.True(t, math.IsNaN(boll.Mid[18]))
+	assert.InDelta(t, 11.12, lastIndicatorValue(boll.Mid), 1e-9)
+	assert.InDelta(t, 12.4380527903, lastIndicatorValue(boll.Upper), 1e-9)
+	assert.InDelta(t, 9.8019472097, lastIndicatorValue(boll.Lower), 1e-9)
+}
+
+func TestIndicator_Oscillators(t *testing.T) {
+	bars := indicatorTestBars()
+	closes := indicator.Closes(bars)
+
+	macd := indicator.MACD(closes, 12, 26, 9)
+	assert.InDelta(t, 0.4722680429, lastIndicatorValue(macd.DIF), 1e-9)
+	assert.InDelta(t, 0.3869749941, lastIndicatorValue(macd.DEA), 1e-9)
+	assert.InDelta(t, 0.1705860976, lastIndicatorValue(macd.MACD), 1e-9)
+
+	rsi := indicator.RSI(closes, 6)
+	assert.True(t, math.IsNaN(rsi[0]))
+	assert.InDelta(t, 74.0309980262, lastIndicatorValue(rsi), 1e-9)
+
+	kdj := indicator.KDJ(bars, 9, 3, 3)
+	assert.InDelta(t, 74.1659555190, lastIndicatorValue(kdj.K), 1e-9)
+	assert.InDelta(t, 72.5337491161, lastIndicatorValue(kdj.D), 1e-9)
+	assert.InDelta(t, 77.4303683247, lastIndicatorValue(kdj.J), 1e-9)
+
+	// 近10根最高 12.6、最低 10.65，收盘 12.3：(12.6 - 12.3) / (12.6 - 10.65) × 100 = 200/13
+	assert.InDelta(t, 200.0/13, lastIndicatorValue(indicator.WR(bars, 10)), 1e-9)
+	assert.InDelta(t, 4000.0/27, lastIndicatorValue(indicator.CCI(bars, 14)), 1e-9)
+}
+
+func TestIndicator_TrendAndVolume(t *testing.T) {
+	bars := indicatorTestBars()
+
+	atr := indicator.ATR(bars, 14)
+	assert.True(t, math.IsNaN(atr[12]))
+	assert.InDelta(t, 8.45/14, lastIndicatorValue(atr), 1e-9)
+
+	dmi := indicator.DMI(bars, 5, 3)
+	assert.True(t, math.IsNaN(dmi.PDI[4]))
+	assert.False(t, math.IsNaN(dmi.PDI[5]))
+	assert.InDelta(t, 2000.0/61, lastIndicatorValue(dmi.PDI), 1e-9)
+	assert.InDelta(t, 1000.0/61, lastIndicatorValue(dmi.MDI), 1e-9)
+	assert.InDelta(t, 2900.0/117, lastIndicatorValue(dmi.ADX), 1e-9)
+	assert.InDelta(t, 41.5995115995, lastIndicatorValue(dmi.ADXR), 1e-9)
+
+	assert.Equal(t, 5250.0, lastIndicatorValue(indicator.OBV(bars)))
+	assert.InDelta(t, 11.8998406973, lastIndicatorValue(indicator.VWAP(bars, 5)), 1e-9)
+}
+
+// TestIndicator_HandDerived 3根K线的手算结果，同时覆盖递推指标自首根K线起有值的约定
+func TestIndicator_HandDerived(t *testing.T) {
+	bars := []types.MarketData{
+		{High: 10, Low: 8, Close: 9, Volume: 100},
+		{High: 12, Low: 9, Close: 12, Volume: 200},
+		{High: 11, Low: 7, Close: 7, Volume: 300},
+	}
+
+	// RSV：50、(12 - 8) / (12 - 8) × 100 = 100、(7 - 7) / (12 - 7) × 100 = 0
+	// K = (RSV + 2 × 前K) / 3：50、200/3、400/9；D = (K + 2 × 前D) / 3：50、500/9、1400/27
+	kdj := indicator.KDJ(bars, 9, 3, 3)
+	expectedK := []float64{50, 200.0 / 3, 400.0 / 9}
+	expectedD := []float64{50, 500.0 / 9, 1400.0 / 27}
+	for i := range bars {
+		assert.InDelta(t, expectedK[i], kdj.K[i], 1e-9)
+		assert.InDelta(t, expectedD[i], kdj.D[i], 1e-9)
+		assert.InDelta(t, 3*expectedK[i]-2*expectedD[i], kdj.J[i], 1e-9)
+	}
+
+	// WR = (HHV - C) / (HHV - LLV) × 100：(10 - 9) / 2、(12 - 12) / 4、(12 - 7) / 5
+	assert.Equal(t, []float64{50, 0, 100}, indicator.WR(bars, 10))
+
+	// EMA(C, 3) = (2 × C + 2 × 前值) / 4，首值取收盘价：9、10.5、8.75
+	assert.Equal(t, []float64{9, 10.5, 8.75}, indicator.EMA(indicator.Closes(bars), 3))
+
+	// SMA(C, 3, 1) = (C + 2 × 前值) / 3：9、10、9
+	assert.Equal(t, []float64{9, 10, 9}, indicator.SMA(indicator.Closes(bars), 3, 1))
+
+	// OBV 首根为0，上涨加量、下跌减量
+	assert.Equal(t, []float64{0, 200, -100}, indicator.OBV(bars))
+
+	// 固定窗口指标在窗口填满前为 NaN
+	ma := indicator.MA(indicator.Closes(bars), 3)
+	assert.True(t, math.IsNaN(ma[1]))
+	assert.InDelta(t, 28.0/3, ma[2], 1e-9)
+}
+
+func TestIndicator_StreamMatchesBatch(t *testing.T) {
+	bars := indicatorTestBars()
+	batch := indicator.KDJ(bars, 9, 3, 3)
+
+	stream := indicator.NewKDJStream(9, 3, 3)
+	for i, bar := range bars {
+		k, d, j := stream.Next(bar)
+		assert.Equal(t, batch.K[i], k)
+		assert.Equal(t, batch.D[i], d)
+		assert.Equal(t, batch.J[i], j)
+	}
+
+	ma := indicator.NewMAStream(3)
+	ma.Next(1)
+	ma.Next(2)
+	assert.Equal(t, 2.0, ma.Next(3))
+	assert.Equal(t, 3.0, ma.Next(4))
+}
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"testing"
+
+	adataErrors "github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/sentiment"
+	"github.com/onepiecelover/adata-go/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSentiment_GetDragonTiger_InvalidDate(t *testing.T) {
+	s := sentiment.New()
+
+	_, err := s.GetDragonTiger("invalid", "")
+	assert.Error(t, err, "Should return error for invalid start date")
+
+	// 开始日期晚于结束日期
+	_, err = s.GetDragonTiger("2024-01-10", "2024-01-01")
+	assert.Error(t, err, "Should return error when start date is after end date")
+
+	if adataErr, ok := err.(*adataErrors.ADataError); ok {
+		assert.Equal(t, adataErrors.ErrInvalidDateFormat.Code, adataErr.Code)
+	}
+}
+
+func TestSentiment_GetDragonTigerDetail_Invalid(t *testing.T) {
+	s := sentiment.New()
+
+	_, err := s.GetDragonTigerDetail("invalid", "2024-01-10")
+	assert.Equal(t, adataErrors.ErrInvalidStockCode, err, "Should return ErrInvalidStockCode for invalid stock code")
+
+	// 明细查询必须指定交易日期
+	_, err = s.GetDragonTigerDetail("000001", "")
+	assert.Equal(t, adataErrors.ErrInvalidDateFormat, err, "Should return ErrInvalidDateFormat for empty date")
+}
+
+func TestSentiment_GetBranchHistory_Invalid(t *testing.T) {
+	s := sentiment.New()
+
+	_, err := s.GetBranchHistory("", "", "")
+	assert.Error(t, err, "Should return error for empty branch code")
+
+	_, err = s.GetBranchHistory(`1")(TRADE_DATE>="2000-01-01`, "", "")
+	assert.Error(t, err, "Should reject non-numeric branch code")
+
+	_, err = s.GetBranchHistory("10656871", "2024/13/01", "")
+	assert.Equal(t, adataErrors.ErrInvalidDateFormat, err, "Should return ErrInvalidDateFormat for invalid date")
+}
+
+func TestBuildLimitStreakStats(t *testing.T) {
+	pool := []types.LimitPool{
+		{StockCode: "600001", TradeDate: "2024-01-10", Streak: 1},
+		{StockCode: "600002", TradeDate: "2024-01-10", Streak: 3},
+		{StockCode: "000001", TradeDate: "2024-01-10", Streak: 1},
+		{StockCode: "300001", TradeDate: "2024-01-10", Streak: 0}, // 首板数据缺失时按1计
+	}
+
+	stats := sentiment.BuildLimitStreakStats(pool)
+	assert.Len(t, stats, 2)
+
+	assert.Equal(t, 3, stats[0].Streak)
+	assert.Equal(t, 1, stats[0].Count)
+	assert.Equal(t, []string{"600002"}, stats[0].StockCodes)
+
+	assert.Equal(t, 1, stats[1].Streak)
+	assert.Equal(t, 3, stats[1].Count)
+	assert.Equal(t, "2024-01-10", stats[1].TradeDate)
+}
+
+func TestSentiment_GetLimitUpPool_InvalidDate(t *testing.T) {
+	s := sentiment.New()
+
+	_, err := s.GetLimitUpPool("invalid")
+	assert.Equal(t, adataErrors.ErrInvalidDateFormat, err, "Should return ErrInvalidDateFormat for invalid date")
+}
+
+func TestBuildMarketBreadth(t *testing.T) {
+	quotes := []types.CurrentMarket{
+		{StockCode: "600001", ShortName: "测试一", Price: 11.00, PreClose: 10.00, ChangePct: 10.00, Amount: 100},
+		{StockCode: "000001", ShortName: "测试二", Price: 9.00, PreClose: 10.00, ChangePct: -10.00, Amount: 200},
+		{StockCode: "300001", ShortName: "测试三", Price: 11.00, PreClose: 10.00, ChangePct: 10.00, Amount: 300}, // 创业板未涨停
+		{StockCode: "600002", ShortName: "ST测试", Price: 10.50, PreClose: 10.00, ChangePct: 5.00, Amount: 400}, // 主板ST涨停
+		{StockCode: "430001", ShortName: "测试五", Price: 10.00, PreClose: 10.00, ChangePct: 0, Amount: 500},
+		{StockCode: "600003", ShortName: "N新股", Price: 20.00, PreClose: 10.00, ChangePct: 100.00, Amount: 600}, // 新股不计涨停
+		{StockCode: "688001", ShortName: "C新股", Price: 12.00, PreClose: 10.00, ChangePct: 20.00, Amount: 700},  // 上市初期无涨跌幅限制
+		{StockCode: "688002", ShortName: "测试七", Price: 12.00, PreClose: 10.00, ChangePct: 20.00, Amount: 800},  // 科创板涨停
+		{StockCode: "600004", ShortName: "停牌股", Price: 0, PreClose: 10.00},                                     // 停牌不参与统计
+	}
+
+	breadth := sentiment.BuildMarketBreadth("2024-01-10", quotes, map[string]bool{"688001": true})
+
+	assert.Equal(t, "2024-01-10", breadth.TradeDate)
+	assert.Equal(t, 8, breadth.Total)
+	assert.Equal(t, 6, breadth.Advancers)
+	assert.Equal(t, 1, breadth.Decliners)
+	assert.Equal(t, 1, breadth.Unchanged)
+	assert.Equal(t, 3, breadth.LimitUp)
+	assert.Equal(t, 1, breadth.LimitDown)
+	assert.Equal(t, 3600.0, breadth.TotalAmount)
+	assert.Equal(t, 2600.0, breadth.AmountByExchange["SH"])
+	assert.Equal(t, 500.0, breadth.AmountByExchange["SZ"])
+	assert.Equal(t, 500.0, breadth.AmountByExchange["BJ"])
+
+	counts := make(map[string]int)
+	total := 0
+	for _, bucket := range breadth.ChangeBuckets {
+		counts[bucket.Label] = bucket.Count
+		total += bucket.Count
+	}
+	assert.Len(t, breadth.ChangeBuckets, 11)
+	assert.Equal(t, breadth.Total, total)
+	assert.Equal(t, 1, counts["<=-9%"])
+	assert.Equal(t, 1, counts["0%"])
+	assert.Equal(t, 1, counts["5%~7%"])
+	assert.Equal(t, 5, counts[">=9%"])
+}
+
+func TestSentiment_GetBlockTrade_Invalid(t *testing.T) {
+	s := sentiment.New()
+
+	_, err := s.GetBlockTrade("invalid", "", "")
+	assert.Equal(t, adataErrors.ErrInvalidStockCode, err, "Should return ErrInvalidStockCode for invalid stock code")
+
+	_, err = s.GetBlockTrade("", "invalid", "")
+	assert.Equal(t, adataErrors.ErrInvalidDateFormat, err, "Should return ErrInvalidDateFormat for invalid date")
+}
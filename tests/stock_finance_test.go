@@ -0,0 +1,523 @@
+package tests
+
+import (
+	stderrors "errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	adataErrors "github.com/onepiecelover/adata-go/pkg/common/errors"
+	"github.com/onepiecelover/adata-go/pkg/stock/finance"
+	"github.com/onepiecelover/adata-go/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func quarterlyTestProfits() []types.Profit {
+	return []types.Profit{
+		{StockCode: "600000", ReportDate: "2023-06-30 00:00:00", NoticeDate: "2023-08-20", NetProfit: 50},
+		{StockCode: "600000", ReportDate: "2023-09-30 00:00:00", NoticeDate: "2023-10-28", NetProfit: 80},
+		{StockCode: "600000", ReportDate: "2023-12-31 00:00:00", NoticeDate: "2024-03-28", NetProfit: 100},
+		{StockCode: "600000", ReportDate: "2024-03-31 00:00:00", NoticeDate: "2024-04-28", NetProfit: 30},
+		{StockCode: "600000", ReportDate: "2024-06-30 00:00:00", NoticeDate: "2024-08-20", NetProfit: 60},
+		// 追溯调整后的2024年中报，以最新公告为准
+		{StockCode: "600000", ReportDate: "2024-06-30 00:00:00", NoticeDate: "2024-10-30", NetProfit: 65},
+		{StockCode: "600000", ReportDate: "2024-09-30 00:00:00", NoticeDate: "2024-10-30", NetProfit: 90},
+	}
+}
+
+func TestSingleQuarterProfit(t *testing.T) {
+	quarters := finance.SingleQuarterProfit(quarterlyTestProfits())
+
+	// 2023年中报缺少一季报，无法推导
+	dates := make([]string, 0, len(quarters))
+	values := make([]float64, 0, len(quarters))
+	for _, q := range quarters {
+		dates = append(dates, q.ReportDate)
+		values = append(values, q.NetProfit)
+		assert.Equal(t, finance.ReportTypeSingleQuarter, q.ReportType)
+	}
+
+	assert.Equal(t, []string{"2023-09-30", "2023-12-31", "2024-03-31", "2024-06-30", "2024-09-30"}, dates)
+	assert.Equal(t, []float64{30, 20, 30, 35, 25}, values)
+}
+
+func TestTTMProfit(t *testing.T) {
+	ttm := finance.TTMProfit(quarterlyTestProfits())
+
+	values := make(map[string]float64)
+	for _, row := range ttm {
+		values[row.ReportDate] = row.NetProfit
+	}
+
+	// 2024年一季报缺少2023年一季报，无法推导
+	assert.Equal(t, map[string]float64{
+		"2023-12-31": 100,
+		"2024-06-30": 115, // 65 + 100 - 50
+		"2024-09-30": 110, // 90 + 100 - 80
+	}, values)
+}
+
+func TestSingleQuarterCashFlow(t *testing.T) {
+	flows := []types.CashFlow{
+		{ReportDate: "2024-03-31", NetCashFlowsOperAct: 10, CashBeginPeriod: 100, CashEndPeriod: 110},
+		{ReportDate: "2024-06-30", NetCashFlowsOperAct: 25, CashBeginPeriod: 100, CashEndPeriod: 125},
+	}
+
+	quarters := finance.SingleQuarterCashFlow(flows)
+	assert.Len(t, quarters, 2)
+	assert.Equal(t, 15.0, quarters[1].NetCashFlowsOperAct)
+	assert.Equal(t, 110.0, quarters[1].CashBeginPeriod)
+	assert.Equal(t, 125.0, quarters[1].CashEndPeriod)
+}
+
+func TestFinanceRatios(t *testing.T) {
+	balances := []types.BalanceSheet{
+		{StockCode: "600000", ReportDate: "2023-12-31", TotalAssets: 1000, TotalEquity: 400, CurrentAssets: 300, CurrentLiabilities: 200, Inventory: 100},
+		{StockCode: "600000", ReportDate: "2024-12-31", TotalAssets: 1200, TotalEquity: 600, CurrentAssets: 400, CurrentLiabilities: 200, Inventory: 100, ShortTermBorrowing: 50, LongTermBorrowing: 150},
+	}
+	profits := []types.Profit{
+		{ReportDate: "2023-12-31", TotalOperatingRevenue: 800, NetProfitAttrSH: 80},
+		{ReportDate: "2024-12-31", TotalOperatingRevenue: 1100, OperatingRevenue: 1000, OperatingCost: 700,
+			TotalProfit: 140, FinExpense: 10, IncomeTaxExpense: 35, NetProfit: 110, NetProfitAttrSH: 100},
+	}
+	flows := []types.CashFlow{
+		{ReportDate: "2024-12-31", NetCashFlowsOperAct: 150, PurchaseAssets: 60},
+	}
+	shares := []types.StockShares{
+		{ChangeDate: "2020-01-01", TotalShares: 80},
+		{ChangeDate: "2024-06-01", TotalShares: 100},
+		{ChangeDate: "2025-06-01", TotalShares: 120},
+	}
+
+	ratios := finance.Ratios(balances, profits, flows, shares)
+	assert.Len(t, ratios, 2)
+
+	r := ratios[1]
+	assert.Equal(t, "2024-12-31", r.ReportDate)
+	assert.InDelta(t, 0.22, r.ROE, 1e-9)                                           // 110 / 500
+	assert.InDelta(t, r.ROE, r.NetMargin*r.AssetTurnover*r.EquityMultiplier, 1e-9) // 杜邦恒等式
+	assert.InDelta(t, 0.3, r.GrossMargin, 1e-9)
+	assert.InDelta(t, 2.0, r.CurrentRatio, 1e-9)
+	assert.InDelta(t, 1.5, r.QuickRatio, 1e-9)
+	assert.InDelta(t, 15.0, r.InterestCoverage, 1e-9)
+	assert.InDelta(t, 112.5/600, r.ROIC, 1e-9) // 150 × 0.75 / ((400 + 800) / 2)
+	assert.InDelta(t, 90.0, r.FCF, 1e-9)
+	assert.InDelta(t, -40.0/1100, r.AccrualRatio, 1e-9)
+	assert.InDelta(t, 0.375, r.RevenueGrowth, 1e-9)
+	assert.InDelta(t, 0.25, r.NetProfitGrowth, 1e-9)
+	assert.Equal(t, 100.0, r.TotalShares)
+	assert.InDelta(t, 1.0, r.EPS, 1e-9)
+	assert.InDelta(t, 6.0, r.BPS, 1e-9)
+
+	// 每个比率字段都有对应公式
+	ratioType := reflect.TypeOf(types.FinanceRatio{})
+	for i := 0; i < ratioType.NumField(); i++ {
+		tag := ratioType.Field(i).Tag.Get("json")
+		if tag == "stock_code" || tag == "report_date" {
+			continue
+		}
+		assert.NotEmpty(t, finance.RatioFormulas[tag], tag)
+	}
+}
+
+func TestProfitAsOf(t *testing.T) {
+	profits := quarterlyTestProfits()
+
+	// 2024-09-01 时2024年中报仅有原始版本，三季报尚未公告
+	known := finance.ProfitAsOf(profits, "2024-09-01")
+	assert.Len(t, known, 5)
+	last := known[len(known)-1]
+	assert.Equal(t, "2024-06-30", last.ReportDate[:10])
+	assert.Equal(t, 60.0, last.NetProfit)
+
+	known = finance.ProfitAsOf(profits, "2024-10-30")
+	assert.Len(t, known, 6)
+	assert.Equal(t, 65.0, known[4].NetProfit)
+
+	versions := finance.ProfitVersions(profits, "2024-06-30")
+	assert.Len(t, versions, 2)
+	assert.Equal(t, 60.0, versions[0].NetProfit)
+	assert.Equal(t, 65.0, versions[1].NetProfit)
+
+	assert.Empty(t, finance.ProfitAsOf(profits, "2023-01-01"))
+}
+
+func TestDividendYieldTTM(t *testing.T) {
+	valuations := []types.Valuation{
+		{TradeDate: "2024-06-03", Close: 10},
+		{TradeDate: "2024-07-15", Close: 10},
+		{TradeDate: "2025-06-03", Close: 8},
+	}
+	dividends := []types.Dividend{
+		{ExDate: "2023-06-03", CashPerShare: 0.3}, // 恰好满一年，不计入
+		{ExDate: "2024-07-10", CashPerShare: 0.4},
+		{CashPerShare: 1}, // 未确定除息日
+	}
+
+	result := finance.DividendYieldTTM(valuations, dividends)
+	assert.InDelta(t, 0, result[0].DividendYield, 1e-9)
+	assert.InDelta(t, 0.04, result[1].DividendYield, 1e-9)
+	assert.InDelta(t, 0.05, result[2].DividendYield, 1e-9)
+	assert.Equal(t, 0.0, valuations[1].DividendYield) // 不修改入参
+
+	// 派息后10转10，收盘价随之减半，每股派息按转增后股本折算；同一方案的送转同样折算
+	valuations = []types.Valuation{
+		{TradeDate: "2024-07-15", Close: 10},
+		{TradeDate: "2024-09-02", Close: 5},
+		{TradeDate: "2025-06-03", Close: 4},
+	}
+	dividends = []types.Dividend{
+		{ExDate: "2024-07-10", CashPerShare: 0.4},
+		{ExDate: "2024-08-01", TransferRatio: 1},
+		{ExDate: "2025-05-20", CashPerShare: 0.7, BonusRatio: 0.2, TransferRatio: 0.2},
+	}
+
+	result = finance.DividendYieldTTM(valuations, dividends)
+	assert.InDelta(t, 0.04, result[0].DividendYield, 1e-9)
+	assert.InDelta(t, 0.04, result[1].DividendYield, 1e-9)
+	assert.InDelta(t, (0.4/2/1.4+0.7/1.4)/4, result[2].DividendYield, 1e-9)
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{10, 20, 15, 5, 25}
+
+	ranks := finance.PercentileRank(values, 0)
+	assert.Equal(t, []float64{1, 1, 2.0 / 3, 0.25, 1}, ranks)
+
+	ranks = finance.PercentileRank(values, 3)
+	assert.InDelta(t, 1.0/3, ranks[3], 1e-9) // 窗口 [20, 15, 5]
+
+	assert.InDelta(t, 15, finance.Percentile(values, 0.5), 1e-9)
+	assert.InDelta(t, 9, finance.Percentile(values, 0.2), 1e-9) // 5 + (10 - 5) × 0.8
+	assert.InDelta(t, 25, finance.Percentile(values, 1), 1e-9)
+
+	// 亏损期市盈率不参与分位计算
+	pe := finance.ValuationSeries([]types.Valuation{{PETTM: 10}, {PETTM: -5}, {PETTM: 20}}, func(v types.Valuation) float64 {
+		return v.PETTM
+	})
+	ranks = finance.PercentileRank(pe, 0)
+	assert.True(t, math.IsNaN(ranks[1]))
+	assert.Equal(t, 1.0, ranks[2])
+	assert.InDelta(t, 15, finance.Percentile(pe, 0.5), 1e-9)
+}
+
+func TestEarningsForecast_Invalid(t *testing.T) {
+	f := finance.NewStockFinance()
+
+	_, err := f.GetEarningsForecast("12345")
+	assert.Equal(t, adataErrors.ErrInvalidStockCode, err)
+
+	_, err = f.GetEarningsExpress("abcdef")
+	assert.Equal(t, adataErrors.ErrInvalidStockCode, err)
+
+	_, err = f.GetEarningsForecastByPeriod("2024/13/31")
+	assert.Equal(t, adataErrors.ErrInvalidDateFormat, err)
+
+	_, err = f.GetEarningsExpressByPeriod("")
+	assert.Equal(t, adataErrors.ErrInvalidDateFormat, err)
+}
+
+func TestStockFinance_GetEarningsForecast(t *testing.T) {
+	f := finance.NewStockFinance()
+
+	forecasts, err := f.GetEarningsForecast("002594")
+	if err != nil {
+		t.Logf("Network request failed (expected in some environments): %v", err)
+		return
+	}
+	assert.NotEmpty(t, forecasts)
+	for _, item := range forecasts {
+		assert.Equal(t, "002594", item.StockCode)
+		assert.Len(t, item.ReportDate, 10)
+	}
+}
+
+func TestStockFinance_GetEarningsForecastByPeriod(t *testing.T) {
+	f := finance.NewStockFinance()
+
+	forecasts, err := f.GetEarningsForecastByPeriod("2023-12-31")
+	if err != nil {
+		t.Logf("Network request failed (expected in some environments): %v", err)
+		return
+	}
+	// 年报业绩预告为全市场披露，数量远超单页
+	assert.Greater(t, len(forecasts), 500)
+	for _, item := range forecasts {
+		assert.Equal(t, "2023-12-31", item.ReportDate)
+	}
+}
+
+func TestStockFinance_GetEarningsExpress(t *testing.T) {
+	f := finance.NewStockFinance()
+
+	expresses, err := f.GetEarningsExpress("000001")
+	if err != nil {
+		t.Logf("Network request failed (expected in some environments): %v", err)
+		return
+	}
+	assert.NotEmpty(t, expresses)
+	for _, item := range expresses {
+		assert.Equal(t, "000001", item.StockCode)
+	}
+}
+
+func TestStockFinance_GetEarningsExpressByPeriod(t *testing.T) {
+	f := finance.NewStockFinance()
+
+	expresses, err := f.GetEarningsExpressByPeriod("2023-12-31")
+	if err != nil {
+		t.Logf("Network request failed (expected in some environments): %v", err)
+		return
+	}
+	assert.NotEmpty(t, expresses)
+	for _, item := range expresses {
+		assert.Equal(t, "2023-12-31", item.ReportDate)
+	}
+}
+
+func TestStockFinance_EarningsEmptyPeriod(t *testing.T) {
+	f := finance.NewStockFinance()
+
+	// 交易所成立前的报告期没有任何预告和快报，应返回空结果而不是错误
+	forecasts, err := f.GetEarningsForecastByPeriod("1990-12-31")
+	if err != nil {
+		if adataErr, ok := err.(*adataErrors.ADataError); ok && adataErr.Code == adataErrors.ErrNoDataFound.Code {
+			t.Fatalf("Empty period should not be an error: %v", err)
+		}
+		t.Logf("Network request failed (expected in some environments): %v", err)
+		return
+	}
+	assert.Empty(t, forecasts)
+
+	expresses, err := f.GetEarningsExpressByPeriod("1990-12-31")
+	if err != nil {
+		if adataErr, ok := err.(*adataErrors.ADataError); ok && adataErr.Code == adataErrors.ErrNoDataFound.Code {
+			t.Fatalf("Empty period should not be an error: %v", err)
+		}
+		t.Logf("Network request failed (expected in some environments): %v", err)
+		return
+	}
+	assert.Empty(t, expresses)
+}
+
+func TestStockFinance_GetBalanceByPeriod(t *testing.T) {
+	f := finance.NewStockFinance()
+
+	balances, err := f.GetBalanceByPeriod("2023-12-31")
+	if err != nil {
+		t.Logf("Network request failed (expected in some environments): %v", err)
+		return
+	}
+
+	// 2023年年报披露公司超过5000家，结果应覆盖全市场且不重复
+	assert.Greater(t, len(balances), 5000)
+	seen := make(map[string]bool, len(balances))
+	for _, item := range balances {
+		assert.Equal(t, "2023-12-31", item.ReportDate)
+		assert.False(t, seen[item.StockCode], item.StockCode)
+		seen[item.StockCode] = true
+	}
+	assert.True(t, seen["600519"], "Should include 600519")
+	assert.True(t, seen["300750"], "Should include 300750")
+}
+
+func TestStockFinance_GetMainBusiness_Invalid(t *testing.T) {
+	f := finance.NewStockFinance()
+
+	_, err := f.GetMainBusiness("12345", "")
+	assert.Equal(t, adataErrors.ErrInvalidStockCode, err)
+
+	_, err = f.GetMainBusiness("600519", "2023/13/31")
+	assert.Equal(t, adataErrors.ErrInvalidDateFormat, err)
+}
+
+func TestStockFinance_GetMainBusiness(t *testing.T) {
+	f := finance.NewStockFinance()
+
+	items, err := f.GetMainBusiness("600519", "2023-12-31")
+	if err != nil {
+		t.Logf("Network request failed (expected in some environments): %v", err)
+		return
+	}
+
+	assert.NotEmpty(t, items)
+	categories := make(map[string]bool)
+	for _, item := range items {
+		assert.Equal(t, "600519", item.StockCode)
+		assert.Equal(t, "2023-12-31", item.ReportDate)
+		categories[item.Category] = true
+	}
+	assert.True(t, categories[finance.MainBusinessByProduct], "Should include product breakdown")
+}
+
+func TestStockFinance_GetMainBusinessRows(t *testing.T) {
+	var filter, source string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		filter, source = r.URL.Query().Get("filter"), r.URL.Query().Get("source")
+		fmt.Fprint(w, `{"success":true,"code":0,"result":{"pages":1,"count":2,"data":[
+			{"SECURITY_CODE":"600519","REPORT_DATE":"2023-12-31 00:00:00","MAINOP_TYPE":"2","ITEM_NAME":" 茅台酒 ","MAIN_BUSINESS_INCOME":1.2e11,"MBI_RATIO":0.86,"MAIN_BUSINESS_COST":8.0e9,"MBC_RATIO":0.7,"MAIN_BUSINESS_RPOFIT":1.12e11,"MBR_RATIO":0.88,"GROSS_RPOFIT_RATIO":0.93,"RANK":1},
+			{"SECURITY_CODE":"600519","REPORT_DATE":"2023-12-31 00:00:00","MAINOP_TYPE":"9","ITEM_NAME":"其他","RANK":1}
+		]}}`)
+	}))
+	defer server.Close()
+
+	f := finance.NewStockFinance()
+	f.SetSecuritiesURL(server.URL)
+
+	items, err := f.GetMainBusiness("600519", "2023-12-31")
+	assert.NoError(t, err)
+	assert.Equal(t, `(SECUCODE="600519.SH")(REPORT_DATE='2023-12-31')`, filter)
+	assert.Equal(t, "HSF10", source)
+
+	if assert.Len(t, items, 2) {
+		assert.Equal(t, types.MainBusiness{
+			StockCode: "600519", ReportDate: "2023-12-31", Category: finance.MainBusinessByProduct, ItemName: "茅台酒",
+			Revenue: 1.2e11, RevenueRatio: 0.86, Cost: 8.0e9, CostRatio: 0.7,
+			Profit: 1.12e11, ProfitRatio: 0.88, GrossMargin: 0.93, Rank: 1,
+		}, items[0])
+
+		// 未知分类代码原样保留
+		assert.Equal(t, "9", items[1].Category)
+	}
+}
+
+// periodServer 模拟数据中心全市场报表接口，共 pages 页，每页一条记录，并记录请求的页码
+func periodServer(pages int) (*httptest.Server, *[]string) {
+	var mu sync.Mutex
+	var requested []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("pageNumber")
+		mu.Lock()
+		requested = append(requested, page)
+		mu.Unlock()
+		fmt.Fprintf(w, `{"success":true,"code":0,"result":{"pages":%d,"count":%d,"data":[{"SECURITY_CODE":"%06s","REPORT_DATE":"2023-12-31 00:00:00"}]}}`, pages, pages, page)
+	}))
+	return server, &requested
+}
+
+// periodRowFinance 返回单条固定记录的数据中心接口对应的财务实例
+func periodRowFinance(t *testing.T, row string) *finance.StockFinance {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"success":true,"code":0,"result":{"pages":1,"count":1,"data":[%s]}}`, row)
+	}))
+	t.Cleanup(server.Close)
+
+	f := finance.NewStockFinance()
+	f.SetDatacenterURL(server.URL)
+	return f
+}
+
+func TestStockFinance_ByPeriodConverters(t *testing.T) {
+	balance, err := periodRowFinance(t, `{"SECURITY_CODE":"600000","REPORT_DATE":"2023-12-31 00:00:00","NOTICE_DATE":"2024-04-20 00:00:00","TOTAL_ASSETS":100,"MONETARYFUNDS":10,"ACCOUNTS_RECE":5,"INVENTORY":3,"TOTAL_LIABILITIES":60,"ACCOUNTS_PAYABLE":4,"TOTAL_EQUITY":40}`).GetBalanceByPeriod("2023-12-31")
+	assert.NoError(t, err)
+	assert.Equal(t, []types.BalanceSheet{{
+		StockCode: "600000", ReportDate: "2023-12-31", ReportType: "年报", NoticeDate: "2024-04-20",
+		TotalAssets: 100, CashAndCashEquivalents: 10, AccountsReceivable: 5, Inventory: 3,
+		TotalLiabilities: 60, AccountsPayable: 4, TotalEquity: 40,
+	}}, balance)
+
+	profit, err := periodRowFinance(t, `{"SECURITY_CODE":"600000","REPORT_DATE":"2023-06-30 00:00:00","TOTAL_OPERATE_INCOME":50,"OPERATE_PROFIT":12,"TOTAL_PROFIT":11,"INCOME_TAX":2,"PARENT_NETPROFIT":8}`).GetProfitByPeriod("2023-06-30")
+	assert.NoError(t, err)
+	if assert.Len(t, profit, 1) {
+		assert.Equal(t, "中报", profit[0].ReportType)
+		assert.Equal(t, 50.0, profit[0].TotalOperatingRevenue)
+		assert.Equal(t, 2.0, profit[0].IncomeTaxExpense)
+		assert.Equal(t, 8.0, profit[0].NetProfitAttrSH)
+	}
+
+	flow, err := periodRowFinance(t, `{"SECURITY_CODE":"600000","REPORT_DATE":"2023-09-30 00:00:00","NETCASH_OPERATE":7,"NETCASH_INVEST":-3,"NETCASH_FINANCE":-1,"CCE_ADD":3}`).GetCashFlowByPeriod("2023-09-30")
+	assert.NoError(t, err)
+	if assert.Len(t, flow, 1) {
+		assert.Equal(t, "三季报", flow[0].ReportType)
+		assert.Equal(t, 7.0, flow[0].NetCashFlowsOperAct)
+		assert.Equal(t, -3.0, flow[0].NetCashFlowsInvAct)
+		assert.Equal(t, 3.0, flow[0].NetIncreaseCash)
+	}
+
+	core, err := periodRowFinance(t, `{"SECURITY_CODE":"600000","SECURITY_NAME_ABBR":"浦发银行","REPORTDATE":"2024-03-31 00:00:00","BASIC_EPS":0.5,"DEDUCT_BASIC_EPS":0.45,"YSTZ":-3.2,"WEIGHTAVG_ROE":2.1}`).GetCoreIndexByPeriod("2024-03-31")
+	assert.NoError(t, err)
+	if assert.Len(t, core, 1) {
+		assert.Equal(t, "2024-03-31", core[0].ReportDate)
+		assert.Equal(t, "一季报", core[0].ReportType)
+		assert.Equal(t, "浦发银行", core[0].ShortName)
+		assert.Equal(t, 0.5, core[0].BasicEPS)
+		// 扣非每股收益与单只股票 GetCoreIndex 一致，归入 NonGaapEPS
+		assert.Equal(t, 0.45, core[0].NonGaapEPS)
+		assert.Equal(t, -3.2, core[0].TotalRevYoYGR)
+		assert.Equal(t, 2.1, core[0].ROEWtd)
+	}
+}
+
+func TestStockFinance_StreamByPeriodInvalidDate(t *testing.T) {
+	f := finance.NewStockFinance()
+
+	err := f.StreamBalanceByPeriod("2023/13/31", 1, func(int, int, []types.BalanceSheet) error { return nil })
+	assert.Equal(t, adataErrors.ErrInvalidDateFormat, err)
+
+	_, err = f.GetProfitByPeriod("")
+	assert.Equal(t, adataErrors.ErrInvalidDateFormat, err)
+}
+
+func TestStockFinance_StreamByPeriodPages(t *testing.T) {
+	server, requested := periodServer(3)
+	defer server.Close()
+
+	f := finance.NewStockFinance()
+	f.SetDatacenterURL(server.URL)
+
+	var pages []int
+	var codes []string
+	err := f.StreamBalanceByPeriod("2023-12-31", 0, func(page, total int, rows []types.BalanceSheet) error {
+		assert.Equal(t, 3, total)
+		pages = append(pages, page)
+		for _, row := range rows {
+			codes = append(codes, row.StockCode)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// startPage 小于1时从第1页开始
+	assert.Equal(t, []string{"1", "2", "3"}, *requested)
+	assert.Equal(t, []int{1, 2, 3}, pages)
+	assert.Equal(t, []string{"000001", "000002", "000003"}, codes)
+}
+
+func TestStockFinance_StreamByPeriodResume(t *testing.T) {
+	server, requested := periodServer(3)
+	defer server.Close()
+
+	f := finance.NewStockFinance()
+	f.SetDatacenterURL(server.URL)
+
+	rows := 0
+	err := f.StreamCashFlowByPeriod("2023-12-31", 3, func(_, _ int, page []types.CashFlow) error {
+		rows += len(page)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3"}, *requested)
+	assert.Equal(t, 1, rows)
+}
+
+func TestStockFinance_StreamByPeriodHandleError(t *testing.T) {
+	server, requested := periodServer(3)
+	defer server.Close()
+
+	f := finance.NewStockFinance()
+	f.SetDatacenterURL(server.URL)
+
+	stop := stderrors.New("stop")
+	err := f.StreamProfitByPeriod("2023-12-31", 1, func(_, _ int, _ []types.Profit) error {
+		return stop
+	})
+
+	// handle 返回的错误原样返回，且不再请求后续页
+	assert.Equal(t, stop, err)
+	assert.Equal(t, []string{"1"}, *requested)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/onepiecelover/adata-go/pkg/common/errors"
 	"github.com/onepiecelover/adata-go/pkg/stock/info"
+	"github.com/onepiecelover/adata-go/pkg/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -118,3 +119,218 @@ func TestStockInfo_MockTest(t *testing.T) {
 	// 由于时间限制，暂时跳过Mock测试的实现
 	t.Skip("Mock tests not implemented yet")
 }
+
+func TestStockInfo_Holders_InvalidCode(t *testing.T) {
+	stockInfo := info.NewStockInfo()
+
+	_, err := stockInfo.GetShareholderCount("invalid")
+	assert.Equal(t, errors.ErrInvalidStockCode, err)
+
+	_, err = stockInfo.GetTopHolders("12345")
+	assert.Equal(t, errors.ErrInvalidStockCode, err)
+
+	_, err = stockInfo.GetTopFloatHolders("")
+	assert.Equal(t, errors.ErrInvalidStockCode, err)
+}
+
+func TestStockInfo_GetConceptConstituentTHS_Invalid(t *testing.T) {
+	stockInfo := info.NewStockInfo()
+
+	_, err := stockInfo.GetConceptConstituentTHS("")
+	assert.Error(t, err, "Should return error for empty concept code")
+
+	_, err = stockInfo.GetConceptConstituentTHS("abc")
+	assert.Error(t, err, "Should return error for non-numeric concept code")
+}
+
+func TestStockInfo_GetBoardConstituentEast_Invalid(t *testing.T) {
+	stockInfo := info.NewStockInfo()
+
+	invalidCodes := []string{"", "0493", "BK49", "BKABCD"}
+	for _, code := range invalidCodes {
+		_, err := stockInfo.GetBoardConstituentEast(code)
+		assert.Error(t, err, "Should return error for invalid board code: %s", code)
+	}
+}
+
+func TestStockInfo_GetIndexConstituent_Invalid(t *testing.T) {
+	stockInfo := info.NewStockInfo()
+
+	_, err := stockInfo.GetIndexConstituent("abc", "")
+	assert.Error(t, err, "Should return error for invalid index code")
+
+	_, err = stockInfo.GetIndexConstituent("000300", "2024/13/45")
+	assert.Equal(t, errors.ErrInvalidDateFormat, err)
+
+	// 上证50仅提供最新快照
+	_, err = stockInfo.GetIndexConstituentHistory("000016", "2024-01-01", "2024-03-31")
+	assert.Error(t, err, "Should return error for index without history source")
+
+	_, err = stockInfo.GetIndexConstituentHistory("000300", "2024-03-01", "2024-01-01")
+	assert.Error(t, err, "Should return error when start date is after end date")
+}
+
+func TestStockInfo_GetProfile_InvalidCode(t *testing.T) {
+	stockInfo := info.NewStockInfo()
+
+	_, err := stockInfo.GetProfile("invalid")
+	assert.Equal(t, errors.ErrInvalidStockCode, err)
+}
+
+func TestStockStatusFromName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"平安银行", info.StatusNormal},
+		{"ST中天", info.StatusST},
+		{"*ST海投", info.StatusStarST},
+		{"退市海润", info.StatusDelisted},
+		{"华业退", info.StatusDelisted},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, info.StockStatusFromName(test.name), "Failed for name: %s", test.name)
+	}
+}
+
+func TestNameAt(t *testing.T) {
+	history := []types.NameChange{
+		{StockCode: "600001", EffectiveDate: "2020-05-06", OldName: "甲股份", NewName: "ST甲"},
+		{StockCode: "600001", EffectiveDate: "2021-05-06", OldName: "ST甲", NewName: "*ST甲"},
+		{StockCode: "600001", EffectiveDate: "2022-06-01", OldName: "*ST甲", NewName: "甲股份"},
+	}
+
+	assert.Equal(t, "甲股份", info.NameAt(history, "2019-01-01", "当前"))
+	assert.Equal(t, "ST甲", info.NameAt(history, "2020-05-06", "当前"))
+	assert.Equal(t, "*ST甲", info.NameAt(history, "2022-05-31", "当前"))
+	assert.Equal(t, "甲股份", info.NameAt(history, "2023-01-01", "当前"))
+	assert.Equal(t, "当前", info.NameAt(nil, "2023-01-01", "当前"))
+}
+
+func TestBuildUniverse(t *testing.T) {
+	listed := []types.StockCode{
+		{StockCode: "600001", ShortName: "甲股份", ListDate: "2000-01-01"},
+		{StockCode: "000002", ShortName: "乙股份", ListDate: "2021-01-01"},
+		{StockCode: "300003", ShortName: "ST丙"},
+	}
+	delisted := []types.DelistedStock{
+		{StockCode: "600004", ShortName: "丁退", ListDate: "2005-01-01", DelistDate: "2021-03-01"},
+		{StockCode: "000005", ShortName: "戊退", ListDate: "2001-01-01", DelistDate: "2019-01-01"},
+	}
+	changes := []types.NameChange{
+		{StockCode: "600001", EffectiveDate: "2020-05-06", OldName: "甲股份", NewName: "ST甲"},
+		{StockCode: "600001", EffectiveDate: "2022-06-01", OldName: "ST甲", NewName: "甲股份"},
+		{StockCode: "600004", EffectiveDate: "2020-06-01", OldName: "丁股份", NewName: "*ST丁"},
+	}
+
+	// 包含已退市但当日仍上市的股票，不包含尚未上市的股票
+	universe := info.BuildUniverse("2020-01-02", listed, delisted, changes, false)
+	assert.Equal(t, []string{"300003", "600001", "600004"}, universe)
+
+	// 剔除当日为 ST、*ST 的股票，无变更记录时按当前简称判断
+	universe = info.BuildUniverse("2020-12-31", listed, delisted, changes, true)
+	assert.Empty(t, universe)
+
+	universe = info.BuildUniverse("2022-12-31", listed, delisted, changes, true)
+	assert.Equal(t, []string{"000002", "600001"}, universe)
+}
+
+func TestStockInfo_History_Invalid(t *testing.T) {
+	stockInfo := info.NewStockInfo()
+
+	_, err := stockInfo.GetNameHistory("invalid")
+	assert.Equal(t, errors.ErrInvalidStockCode, err)
+
+	_, err = stockInfo.GetUniverse("", false)
+	assert.Equal(t, errors.ErrInvalidDateFormat, err)
+}
+
+func TestMergeCorporateActions(t *testing.T) {
+	dividends := []types.Dividend{
+		{ExDate: "2023-07-13", CashPerShare: 0.3, BonusRatio: 0.2, TransferRatio: 0.1},
+		{ExDate: "2022-07-14", CashPerShare: 0.25},
+		{ExDate: "", CashPerShare: 0.5}, // 未实施预案
+	}
+	issues := []types.RightsIssue{
+		{ExDate: "2023-07-13", Ratio: 0.3, Price: 8.5},
+	}
+
+	actions := info.MergeCorporateActions("600000", dividends, issues)
+	assert.Len(t, actions, 2)
+
+	assert.Equal(t, "2022-07-14", actions[0].ExDate)
+	assert.Equal(t, 0.25, actions[0].CashPerShare)
+
+	assert.Equal(t, "600000", actions[1].StockCode)
+	assert.Equal(t, 0.3, actions[1].CashPerShare)
+	assert.InDelta(t, 0.3, actions[1].BonusPerShare, 1e-9)
+	assert.Equal(t, 0.3, actions[1].RightsRatio)
+	assert.Equal(t, 8.5, actions[1].RightsPrice)
+}
+
+func TestMergeCorporateActions_RightsOnly(t *testing.T) {
+	// 从未分红的股票只有配股记录
+	issues := []types.RightsIssue{
+		{ExDate: "2021-03-15", Ratio: 0.2, Price: 6.8},
+	}
+
+	actions := info.MergeCorporateActions("600000", nil, issues)
+	assert.Len(t, actions, 1)
+	assert.Equal(t, "2021-03-15", actions[0].ExDate)
+	assert.Equal(t, 0.0, actions[0].CashPerShare)
+	assert.Equal(t, 0.2, actions[0].RightsRatio)
+
+	assert.Empty(t, info.MergeCorporateActions("600000", nil, nil))
+}
+
+func TestStockInfo_CorporateActions_NoDividend(t *testing.T) {
+	stockInfo := info.NewStockInfo()
+
+	// 百济神州上市以来未分红送转，也未配股
+	actions, err := stockInfo.GetCorporateActions("688235")
+	if err != nil {
+		if adataErr, ok := err.(*errors.ADataError); ok && adataErr.Code == errors.ErrNoDataFound.Code {
+			t.Fatalf("No dividend records should not be an error: %v", err)
+		}
+		t.Logf("Network request failed (expected in some environments): %v", err)
+		return
+	}
+	assert.Empty(t, actions)
+}
+
+func TestStockInfo_CorporateActions_InvalidCode(t *testing.T) {
+	stockInfo := info.NewStockInfo()
+
+	_, err := stockInfo.GetDividend("invalid")
+	assert.Equal(t, errors.ErrInvalidStockCode, err)
+
+	_, err = stockInfo.GetRightsIssue("12345")
+	assert.Equal(t, errors.ErrInvalidStockCode, err)
+
+	_, err = stockInfo.GetCorporateActions("")
+	assert.Equal(t, errors.ErrInvalidStockCode, err)
+}
+
+func TestRecentListings(t *testing.T) {
+	ipos := []types.IPO{
+		{StockCode: "688001", ListingDate: "2024-03-01"},
+		{StockCode: "301001", ListingDate: "2024-03-20"},
+		{StockCode: "603001", ListingDate: "2024-04-02"}, // 晚于查询日期
+		{StockCode: "920001", ListingDate: ""},           // 尚未上市
+	}
+
+	assert.Equal(t, []string{"301001"}, info.RecentListings(ipos, "2024-03-31", 20))
+	assert.Equal(t, []string{"301001", "688001"}, info.RecentListings(ipos, "2024-03-31", 60))
+	assert.Empty(t, info.RecentListings(ipos, "invalid", 60))
+}
+
+func TestStockInfo_GetIPOCalendar_InvalidDate(t *testing.T) {
+	stockInfo := info.NewStockInfo()
+
+	_, err := stockInfo.GetIPOCalendar("2024/13/01", "")
+	assert.Equal(t, errors.ErrInvalidDateFormat, err)
+
+	_, err = stockInfo.GetIPOCalendar("2024-03-01", "2024-01-01")
+	assert.Error(t, err, "Should return error when start date is after end date")
+}
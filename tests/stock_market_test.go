@@ -163,3 +163,63 @@ func TestStockMarket_GetMarketFive_Invalid(t *testing.T) {
 			"Should return ErrInvalidStockCode for invalid stock code")
 	}
 }
+
+func TestStockMarket_GetBoardMarket_Invalid(t *testing.T) {
+	stockMarket := market.NewStockMarket()
+
+	_, err := stockMarket.GetBoardMarket(nil)
+	assert.Error(t, err, "Should return error for nil params")
+
+	_, err = stockMarket.GetBoardMarket(&types.MarketParams{StockCode: "000001", KType: 1})
+	assert.Error(t, err, "Should return error for stock code used as board code")
+
+	_, err = stockMarket.ListBoardMarketCurrent(nil)
+	assert.Error(t, err, "Should return error for empty board codes")
+
+	_, err = stockMarket.ListBoardMarketCurrent([]string{"invalid"})
+	assert.Error(t, err, "Should return error when no valid board code")
+
+	_, err = stockMarket.ListBoardMarketCurrent([]string{"BK0493", "invalid"})
+	assert.Error(t, err, "Should return error when any board code is invalid")
+}
+
+func TestStockMarket_IndexMarket_Invalid(t *testing.T) {
+	stockMarket := market.NewStockMarket()
+
+	_, err := stockMarket.GetIndexMarket(types.IndexCode{IndexCode: "abc"}, "", "", 1)
+	assert.Error(t, err, "Should return error for invalid index code")
+
+	// 无法从代码推断交易所且未设置 Exchange
+	_, err = stockMarket.GetIndexMarketMin(types.IndexCode{IndexCode: "123456"})
+	assert.Error(t, err, "Should return error for unknown exchange")
+
+	_, err = stockMarket.GetIndexMarket(types.IndexCode{IndexCode: "000001", Exchange: "SH"}, "2024/13/01", "", 1)
+	assert.Equal(t, adataErrors.ErrInvalidDateFormat, err)
+
+	_, err = stockMarket.ListIndexMarketCurrent(nil)
+	assert.Error(t, err, "Should return error for empty index list")
+
+	_, err = stockMarket.ListIndexMarketCurrent([]types.IndexCode{{IndexCode: "000300", Exchange: "SH"}, {IndexCode: "abc"}})
+	assert.Error(t, err, "Should return error when any index code is invalid")
+}
+
+func TestStockMarket_ListIndexMarketCurrent_Exchange(t *testing.T) {
+	stockMarket := market.NewStockMarket()
+
+	// 上证指数与深证平安银行同为 000001，通过交易所区分
+	quotes, err := stockMarket.ListIndexMarketCurrent([]types.IndexCode{
+		{IndexCode: "000001", Exchange: "SH"},
+		{IndexCode: "399001", Exchange: "SZ"},
+	})
+	if err != nil {
+		t.Logf("Network request failed (expected in some environments): %v", err)
+		return
+	}
+
+	exchanges := make(map[string]string)
+	for _, quote := range quotes {
+		exchanges[quote.StockCode] = quote.Exchange
+	}
+	assert.Equal(t, "SH", exchanges["000001"])
+	assert.Equal(t, "SZ", exchanges["399001"])
+}
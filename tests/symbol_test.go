@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/onepiecelover/adata-go/pkg/common/symbol"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSymbolParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		code     string
+		exchange string
+		instType symbol.InstrumentType
+	}{
+		{"600000", "600000", "SH", symbol.InstrumentAShare},
+		{"sh600000", "600000", "SH", symbol.InstrumentAShare},
+		{"SH600000", "600000", "SH", symbol.InstrumentAShare},
+		{"600000.SH", "600000", "SH", symbol.InstrumentAShare},
+		{"1.600000", "600000", "SH", symbol.InstrumentAShare},
+		{"000001", "000001", "SZ", symbol.InstrumentAShare},
+		{"sh000001", "000001", "SH", symbol.InstrumentIndex},
+		{"0.399006", "399006", "SZ", symbol.InstrumentIndex},
+		{"900901", "900901", "SH", symbol.InstrumentBShare},
+		{"200002.sz", "200002", "SZ", symbol.InstrumentBShare},
+		{"510300", "510300", "SH", symbol.InstrumentETF},
+		{"159915", "159915", "SZ", symbol.InstrumentETF},
+		{"501018", "501018", "SH", symbol.InstrumentLOF},
+		{"161725", "161725", "SZ", symbol.InstrumentLOF},
+		{"113050", "113050", "SH", symbol.InstrumentConvertible},
+		{"123107", "123107", "SZ", symbol.InstrumentConvertible},
+		{"430047", "430047", "BJ", symbol.InstrumentBSE},
+		{"0.920001", "920001", "BJ", symbol.InstrumentBSE},
+		{"899050", "899050", "BJ", symbol.InstrumentIndex},
+	}
+
+	for _, test := range tests {
+		sym, err := symbol.Parse(test.input)
+		assert.NoError(t, err, "Failed to parse: %s", test.input)
+		assert.Equal(t, test.code, sym.Code, "Failed code for: %s", test.input)
+		assert.Equal(t, test.exchange, sym.Exchange, "Failed exchange for: %s", test.input)
+		assert.Equal(t, test.instType, sym.Type, "Failed type for: %s", test.input)
+	}
+}
+
+func TestSymbolParse_Invalid(t *testing.T) {
+	invalidInputs := []string{"", "60000", "abcdef", "hk600000", "2.600000", "600000.HK"}
+
+	for _, input := range invalidInputs {
+		_, err := symbol.Parse(input)
+		assert.Error(t, err, "Should return error for: %s", input)
+	}
+}
+
+func TestSymbolFormat(t *testing.T) {
+	sym := mustParseSymbol(t, "sh000001")
+	assert.Equal(t, "000001.SH", sym.String())
+	assert.Equal(t, "sh000001", sym.Prefixed())
+	assert.Equal(t, "1.000001", sym.SecID())
+	assert.False(t, sym.IsStock())
+
+	sym = mustParseSymbol(t, "000001")
+	assert.Equal(t, "0.000001", sym.SecID())
+	assert.True(t, sym.IsStock())
+
+	sym = mustParseSymbol(t, "430047")
+	assert.Equal(t, "bj430047", sym.Prefixed())
+	assert.Equal(t, "0.430047", sym.SecID())
+}
+
+func TestSymbolBoard(t *testing.T) {
+	assert.Equal(t, "主板", mustParseSymbol(t, "600000").Board())
+	assert.Equal(t, "创业板", mustParseSymbol(t, "300750").Board())
+	assert.Equal(t, "科创板", mustParseSymbol(t, "688981").Board())
+	assert.Equal(t, "北交所", mustParseSymbol(t, "430047").Board())
+	assert.Equal(t, "", mustParseSymbol(t, "510300").Board())
+}
+
+// mustParseSymbol 解析测试用的常量代码，失败时终止测试
+func mustParseSymbol(t *testing.T, s string) symbol.Symbol {
+	sym, err := symbol.Parse(s)
+	if err != nil {
+		t.Fatalf("证券代码解析失败: %v", err)
+	}
+	return sym
+}
@@ -140,6 +140,13 @@ func TestParseFloat(t *testing.T) {
 	}
 }
 
+func TestToFloat(t *testing.T) {
+	assert.Equal(t, 12.5, utils.ToFloat(12.5))
+	assert.Equal(t, 1234.5, utils.ToFloat("1,234.5"))
+	assert.Equal(t, 0.0, utils.ToFloat("-"))
+	assert.Equal(t, 0.0, utils.ToFloat(nil))
+}
+
 func TestParseInt(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -217,3 +224,68 @@ func TestConvertUnits(t *testing.T) {
 		assert.Equal(t, test.expected, result, "Failed for value: %f, unit: %s", test.value, test.unit)
 	}
 }
+
+func TestGetLimitRatio(t *testing.T) {
+	tests := []struct {
+		stockCode string
+		isST      bool
+		expected  float64
+	}{
+		{"600036", false, 0.10}, // 上海主板
+		{"000001", false, 0.10}, // 深圳主板
+		{"600036", true, 0.05},  // 主板ST
+		{"300059", false, 0.20}, // 创业板
+		{"300059", true, 0.20},  // 创业板ST仍为20%
+		{"688001", false, 0.20}, // 科创板
+		{"430001", false, 0.30}, // 北交所
+		{"830001", true, 0.30},  // 北交所ST
+	}
+
+	for _, test := range tests {
+		result := utils.GetLimitRatio(test.stockCode, test.isST)
+		assert.Equal(t, test.expected, result, "Failed for stock code: %s, isST: %v", test.stockCode, test.isST)
+	}
+}
+
+func TestGetLimitPrice(t *testing.T) {
+	tests := []struct {
+		preClose     float64
+		ratio        float64
+		expectedUp   float64
+		expectedDown float64
+	}{
+		{10.00, 0.10, 11.00, 9.00},
+		{10.05, 0.10, 11.06, 9.05},
+		{3.33, 0.05, 3.50, 3.16},
+		{25.17, 0.20, 30.20, 20.14},
+		{7.77, 0.30, 10.10, 5.44},
+	}
+
+	for _, test := range tests {
+		up, down := utils.GetLimitPrice(test.preClose, test.ratio)
+		assert.Equal(t, test.expectedUp, up, "Failed up price for preClose: %f", test.preClose)
+		assert.Equal(t, test.expectedDown, down, "Failed down price for preClose: %f", test.preClose)
+	}
+}
+
+func TestIsSTName(t *testing.T) {
+	assert.True(t, utils.IsSTName("ST中天"))
+	assert.True(t, utils.IsSTName("*ST海投"))
+	assert.False(t, utils.IsSTName("平安银行"))
+}
+
+func TestIsValidBoardCode(t *testing.T) {
+	assert.True(t, utils.IsValidBoardCode("BK0493"))
+	assert.True(t, utils.IsValidBoardCode("bk1036"))
+	assert.False(t, utils.IsValidBoardCode("0493"))
+	assert.False(t, utils.IsValidBoardCode("BK04931"))
+	assert.False(t, utils.IsValidBoardCode(""))
+}
+
+func TestGetIndexExchange(t *testing.T) {
+	assert.Equal(t, "SH", utils.GetIndexExchange("000001"))
+	assert.Equal(t, "SZ", utils.GetIndexExchange("399001"))
+	assert.Equal(t, "BJ", utils.GetIndexExchange("899050"))
+	assert.Equal(t, "", utils.GetIndexExchange("600000"))
+	assert.Equal(t, "", utils.GetIndexExchange(""))
+}